@@ -5,6 +5,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"strconv"
 	"webApp/entity"
+	"webApp/lib/bwm"
+	"webApp/lib/dematel"
+	v "webApp/lib/variables"
 )
 
 // ReplaceAlternatives godoc
@@ -170,3 +173,303 @@ func (h *Handler) GetCriteria(c *fiber.Ctx) error {
 
 	return c.JSON(criteria)
 }
+
+// CalcPairwise godoc
+// @summary CalcPairwise
+// @description derives criteria weights from pairwise comparisons without saving them
+// @security ApiKeyAuth
+// @id calc-pairwise
+// @tags criteria
+// @accept json
+// @produce json
+// @param input body entity.PairwiseModel true "pairwise comparisons"
+// @param sid query int true "task identifier"
+// @success 200 {object} entity.PairwiseResult
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/pairwise [post]
+func (h *Handler) CalcPairwise(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.CheckAccess(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, errors.New("hasn't access to solution"))
+	}
+
+	var request entity.PairwiseModel
+	if err := c.BodyParser(&request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	result, err := service.Task.CalcPairwise(c.UserContext(), sid, &request)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(result)
+}
+
+// SetPairwiseWeights godoc
+// @summary SetPairwiseWeights
+// @description derives criteria weights from pairwise comparisons and saves them to current task
+// @security ApiKeyAuth
+// @id set-pairwise
+// @tags criteria
+// @accept json
+// @produce json
+// @param input body entity.PairwiseModel true "pairwise comparisons"
+// @param sid query int true "task identifier"
+// @success 200 {object} entity.PairwiseResult
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 422 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/pairwise [put]
+func (h *Handler) SetPairwiseWeights(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.ValidateUser(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, err)
+	}
+
+	var request entity.PairwiseModel
+	if err := c.BodyParser(&request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	result, err := service.Task.SetPairwiseWeights(c.UserContext(), sid, &request)
+	if errors.Is(err, v.InconsistentMatrix) {
+		return sendErrorResponse(c, fiber.StatusUnprocessableEntity, err)
+	} else if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(result)
+}
+
+// SetBWM godoc
+// @summary SetBWM
+// @description saves best-worst comparisons of current expert and returns derived weights
+// @security ApiKeyAuth
+// @id set-bwm
+// @tags criteria
+// @accept json
+// @produce json
+// @param input body bwm.Comparisons true "best-to-others and others-to-worst vectors"
+// @param sid query int true "task identifier"
+// @success 200 {object} bwm.Result
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/bwm [put]
+func (h *Handler) SetBWM(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.CheckAccess(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, errors.New("hasn't access to solution"))
+	}
+
+	var request bwm.Comparisons
+	if err := c.BodyParser(&request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	result, err := service.Task.SetBWM(c.UserContext(), uid, sid, &request)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(result)
+}
+
+// ApplyBWM godoc
+// @summary ApplyBWM
+// @description aggregates best-worst comparisons of all experts and saves weights to criteria of current task
+// @security ApiKeyAuth
+// @id apply-bwm
+// @tags criteria
+// @accept json
+// @produce json
+// @param sid query int true "task identifier"
+// @success 200 {object} entity.Criteria
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/bwm [patch]
+func (h *Handler) ApplyBWM(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.ValidateUser(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, err)
+	}
+
+	criteria, err := service.Task.ApplyBWM(c.UserContext(), sid)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(criteria)
+}
+
+// SetDematel godoc
+// @summary SetDematel
+// @description saves direct-influence matrix between criteria and returns DEMATEL analysis
+// @security ApiKeyAuth
+// @id set-dematel
+// @tags criteria
+// @accept json
+// @produce json
+// @param input body dematel.Influence true "crisp or fuzzy direct-influence matrix"
+// @param sid query int true "task identifier"
+// @success 200 {object} dematel.Result
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/dematel [put]
+func (h *Handler) SetDematel(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.ValidateUser(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, err)
+	}
+
+	var request dematel.Influence
+	if err := c.BodyParser(&request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	result, err := service.Task.SetDematel(c.UserContext(), sid, &request)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(result)
+}
+
+// GetDematel godoc
+// @summary GetDematel
+// @description gets DEMATEL analysis of saved direct-influence matrix
+// @security ApiKeyAuth
+// @id get-dematel
+// @tags criteria
+// @accept json
+// @produce json
+// @param sid query int true "task identifier"
+// @success 200 {object} dematel.Result
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/dematel [get]
+func (h *Handler) GetDematel(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.CheckAccess(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, errors.New("hasn't access to solution"))
+	}
+
+	result, err := service.Task.GetDematel(c.UserContext(), sid)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(result)
+}
+
+// ApplyDematel godoc
+// @summary ApplyDematel
+// @description derives weights from saved DEMATEL analysis and saves them to criteria of current task
+// @security ApiKeyAuth
+// @id apply-dematel
+// @tags criteria
+// @accept json
+// @produce json
+// @param sid query int true "task identifier"
+// @success 200 {object} entity.Criteria
+// @success 400 {object} response
+// @success 403 {object} response
+// @failure 404 {object} response
+// @failure 500 {object} response
+// @router /solution/criteria/dematel [patch]
+func (h *Handler) ApplyDematel(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("this solution not found"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.ValidateUser(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, err)
+	}
+
+	criteria, err := service.Task.ApplyDematel(c.UserContext(), sid)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(criteria)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"strconv"
 	"webApp/lib/eval"
+	v "webApp/lib/variables"
 )
 
 // CreateMatrix godoc
@@ -48,7 +49,7 @@ type RatingsInput struct {
 
 // UpdateMatrix godoc
 // @summary UpdateMatrix
-// @description updates ratings in matrix
+// @description updates ratings in matrix, linguistic ratings may be given by expressions such as "at least Good"
 // @security ApiKeyAuth
 // @id update-matrix
 // @tags matrix
@@ -88,7 +89,10 @@ func (h *Handler) UpdateMatrix(c *fiber.Ctx) error {
 		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
 	}
 
-	if err := service.Matrix.UpdateMatrix(c.UserContext(), sid, mid, ord, request.Ratings); err != nil {
+	err = service.Matrix.UpdateMatrix(c.UserContext(), sid, mid, ord, request.Ratings)
+	if errors.Is(err, v.InvalidExpression) {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	} else if err != nil {
 		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
 	}
 
@@ -134,6 +138,49 @@ func (h *Handler) GetRatings(c *fiber.Ctx) error {
 	return c.JSON(RatingsInput{ratings})
 }
 
+// SetLingScale godoc
+// @summary SetLingScale
+// @description sets own linguistic scale of current expert, which must cover the domain of the task scale
+// @security ApiKeyAuth
+// @id set-ling-scale
+// @tags matrix
+// @accept json
+// @produce json
+// @param input body eval.LinguisticScale true "linguistic scale of expert"
+// @param sid query int true "task identifier"
+// @success 200 {object} response
+// @success 400 {object} response
+// @success 403 {object} response
+// @success 404 {object} response
+// @router /solution/rating/scale [put]
+func (h *Handler) SetLingScale(c *fiber.Ctx) error {
+	uid, err := h.userIdentity(c)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusInternalServerError, err)
+	}
+
+	sid, err := strconv.ParseInt(c.Query("sid"), 10, 64)
+	if err != nil {
+		return sendErrorResponse(c, fiber.StatusNotFound, errors.New("task doesn't specified"))
+	}
+
+	service := h.di.GetInstanceService()
+	if err := service.Task.CheckAccess(c.UserContext(), uid, sid); err != nil {
+		return sendErrorResponse(c, fiber.StatusForbidden, errors.New("hasn't access to solution"))
+	}
+
+	var request eval.LinguisticScale
+	if err := c.BodyParser(&request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	if err := service.Matrix.SetLingScale(c.UserContext(), uid, sid, &request); err != nil {
+		return sendErrorResponse(c, fiber.StatusBadRequest, err)
+	}
+
+	return c.JSON(response{Message: "success"})
+}
+
 // CompleteStatus godoc
 // @summary CompleteStatus
 // @description set status complete for current matrix
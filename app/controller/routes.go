@@ -68,6 +68,13 @@ func (h *Handler) SetAllRoutes(api fiber.Router) {
 		{
 			solCriteria.Put("/", h.ReplaceCriteria)
 			solCriteria.Get("/", h.GetCriteria)
+			solCriteria.Post("/pairwise", h.CalcPairwise)
+			solCriteria.Put("/pairwise", h.SetPairwiseWeights)
+			solCriteria.Put("/bwm", h.SetBWM)
+			solCriteria.Patch("/bwm", h.ApplyBWM)
+			solCriteria.Put("/dematel", h.SetDematel)
+			solCriteria.Get("/dematel", h.GetDematel)
+			solCriteria.Patch("/dematel", h.ApplyDematel)
 		}
 
 		solGroup.Post("/connect", h.ConnectToTask)
@@ -77,6 +84,7 @@ func (h *Handler) SetAllRoutes(api fiber.Router) {
 			solRating.Post("/", h.CreateMatrix)
 			solRating.Put("/", h.UpdateMatrix)
 			solRating.Get("/", h.GetRatings)
+			solRating.Put("/scale", h.SetLingScale)
 			solRating.Patch("/complete", h.CompleteStatus)
 		}
 
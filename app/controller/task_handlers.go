@@ -7,7 +7,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"strconv"
 	"webApp/entity"
+	"webApp/lib"
 	"webApp/lib/eval"
+	"webApp/lib/todim"
 	v "webApp/lib/variables"
 )
 
@@ -18,6 +20,8 @@ type TaskInput struct {
 	TaskType     string               `json:"task_type"`
 	Method       string               `json:"method"`
 	CalcSettings int64                `json:"calc_settings"`
+	Theta        float64              `json:"theta"`
+	Optimistic   bool                 `json:"optimistic_hesitance"`
 	LingScale    eval.LinguisticScale `json:"ling_scale"`
 }
 
@@ -29,6 +33,8 @@ func (t *TaskInput) UnmarshalJSON(data []byte) error {
 		TaskType     *string               `json:"task_type"`
 		Method       *string               `json:"method"`
 		CalcSettings *int64                `json:"calc_settings"`
+		Theta        *float64              `json:"theta"`
+		Optimistic   bool                  `json:"optimistic_hesitance"`
 		LingScale    *eval.LinguisticScale `json:"ling_scale"`
 	}{}
 
@@ -36,7 +42,7 @@ func (t *TaskInput) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	if result.Title == nil || result.TaskType == nil || result.Method == nil || result.CalcSettings == nil ||
-		(*result.Method != v.TOPSIS && *result.Method != v.SMART) ||
+		!isValidMethod(*result.Method) || !isValidSettings(*result.CalcSettings) || (result.Theta != nil && *result.Theta <= 0) ||
 		(*result.TaskType != v.Individuals && *result.TaskType != v.Group) {
 		return errors.New("invalid input arguments for task, check required fields")
 	} else {
@@ -46,6 +52,12 @@ func (t *TaskInput) UnmarshalJSON(data []byte) error {
 		t.Method = *result.Method
 		t.TaskType = *result.TaskType
 		t.CalcSettings = *result.CalcSettings
+		if result.Theta != nil {
+			t.Theta = *result.Theta
+		} else {
+			t.Theta = todim.DefaultTheta
+		}
+		t.Optimistic = result.Optimistic
 		if result.LingScale != nil {
 			t.LingScale = *result.LingScale
 		} else {
@@ -55,6 +67,23 @@ func (t *TaskInput) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func isValidMethod(method string) bool {
+	switch method {
+	case v.TOPSIS, v.SMART, v.VIKOR, v.PROMETHEE, v.ELECTRE, v.MULTIMOORA, v.EDAS, v.CODAS, v.MABAC, v.ARAS, v.TODIM, v.GRA:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidSettings rejects settings whose coefficients, packed as tenths,
+// exceed one.
+func isValidSettings(calcSettings int64) bool {
+	settings := lib.CalcSettings{}
+	settings.Parse(calcSettings)
+	return settings.MixCoefficient <= 10 && settings.WaspasLambda <= 10 && settings.Rho <= 10
+}
+
 type TitleInput struct {
 	Title string `json:"title"`
 }
@@ -131,6 +160,8 @@ func (h *Handler) UpdateTask(c *fiber.Ctx) error {
 		TaskType:     input.TaskType,
 		Method:       input.Method,
 		CalcSettings: input.CalcSettings,
+		Theta:        input.Theta,
+		Optimistic:   input.Optimistic,
 		LingScale:    input.LingScale,
 		Status:       entity.Draft,
 	}
@@ -184,6 +215,8 @@ func (h *Handler) GetTask(c *fiber.Ctx) error {
 		TaskType:     task.TaskType,
 		Method:       task.Method,
 		CalcSettings: task.CalcSettings,
+		Theta:        task.Theta,
+		Optimistic:   task.Optimistic,
 		LingScale:    task.LingScale,
 	}
 
@@ -520,5 +553,5 @@ func (h *Handler) DeactivateStatuses(c *fiber.Ctx) error {
 // @router /solution/defaults [get]
 func (h *Handler) GetDefaultLingScale(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{"number": eval.DefaultNumberScale, "interval": eval.DefaultIntervalScale,
-		"t1fs": eval.DefaultT1FSScale})
+		"t1fs": eval.DefaultT1FSScale, "ns": eval.DefaultNSScale})
 }
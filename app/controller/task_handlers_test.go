@@ -12,6 +12,7 @@ import (
 	"testing"
 	"webApp/entity"
 	"webApp/lib/eval"
+	"webApp/lib/todim"
 	v "webApp/lib/variables"
 	"webApp/usecase"
 	mock_service "webApp/usecase/mocks-service"
@@ -149,6 +150,7 @@ func TestHandler_UpdateTask(t *testing.T) {
 				TaskType:     "individual",
 				Method:       "topsis",
 				CalcSettings: 42,
+				Theta:        todim.DefaultTheta,
 				LingScale:    *eval.DefaultT1FSScale,
 				Status:       entity.Draft,
 			},
@@ -173,6 +175,7 @@ func TestHandler_UpdateTask(t *testing.T) {
 				TaskType:     "individual",
 				Method:       "topsis",
 				CalcSettings: 42,
+				Theta:        todim.DefaultTheta,
 				LingScale:    *eval.DefaultT1FSScale,
 				Status:       entity.Draft,
 			},
@@ -197,6 +200,45 @@ func TestHandler_UpdateTask(t *testing.T) {
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"message":"invalid input arguments for task, check required fields"}`,
 		},
+		{
+			name:       "Mixing coefficient above one",
+			paramsName: "sid",
+			userIdentify: func(c *fiber.Ctx) (int64, error) {
+				return 1, nil
+			},
+			inputBody: `{"title": "title", "task_type": "individual", "method": "topsis", "calc_settings": 755914244096}`,
+			inputTask: entity.TaskModel{},
+			mockBehavior: func(r *mock_service.MockTask, di *mock_service.MockDiService, task *entity.TaskModel, svc *usecase.Service) {
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"message":"invalid input arguments for task, check required fields"}`,
+		},
+		{
+			name:       "WASPAS lambda above one",
+			paramsName: "sid",
+			userIdentify: func(c *fiber.Ctx) (int64, error) {
+				return 1, nil
+			},
+			inputBody: `{"title": "title", "task_type": "individual", "method": "smart", "calc_settings": 193514046488576}`,
+			inputTask: entity.TaskModel{},
+			mockBehavior: func(r *mock_service.MockTask, di *mock_service.MockDiService, task *entity.TaskModel, svc *usecase.Service) {
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"message":"invalid input arguments for task, check required fields"}`,
+		},
+		{
+			name:       "Distinguishing coefficient above one",
+			paramsName: "sid",
+			userIdentify: func(c *fiber.Ctx) (int64, error) {
+				return 1, nil
+			},
+			inputBody: `{"title": "title", "task_type": "individual", "method": "gra", "calc_settings": 792633534417207296}`,
+			inputTask: entity.TaskModel{},
+			mockBehavior: func(r *mock_service.MockTask, di *mock_service.MockDiService, task *entity.TaskModel, svc *usecase.Service) {
+			},
+			expectedStatusCode:   400,
+			expectedResponseBody: `{"message":"invalid input arguments for task, check required fields"}`,
+		},
 		{
 			name:       "Wrong URL params",
 			paramsName: "smt",
@@ -249,6 +291,7 @@ func TestHandler_UpdateTask(t *testing.T) {
 				TaskType:     "individual",
 				Method:       "topsis",
 				CalcSettings: 42,
+				Theta:        todim.DefaultTheta,
 				LingScale:    *eval.DefaultT1FSScale,
 				Status:       entity.Draft,
 			},
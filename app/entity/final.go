@@ -7,49 +7,79 @@ import (
 	"webApp/lib/eval"
 	"webApp/lib/matrix"
 	v "webApp/lib/variables"
+	"webApp/lib/vikor"
 )
 
 type FinalModel struct {
-	FID          int64                 `json:"fid" db:"fid"`
-	Result       matrix.RankedList     `json:"result" db:"result"`
-	SensAnalysis lib.SensitivityResult `json:"sens_analysis" db:"sens_analysis"`
-	Threshold    float64               `json:"threshold" db:"threshold"`
-	LastChange   time.Time             `json:"last_change" db:"last_change"`
+	FID          int64                     `json:"fid" db:"fid"`
+	Result       matrix.RankedList         `json:"result" db:"result"`
+	Acceptance   *vikor.Acceptance         `json:"acceptance,omitempty" db:"acceptance"`
+	PartialOrder *matrix.PartialOrder      `json:"partial_order,omitempty" db:"partial_order"`
+	SubRankings  *matrix.RankedLists       `json:"sub_rankings,omitempty" db:"sub_rankings"`
+	Reliability  *matrix.Reliabilities     `json:"reliability,omitempty" db:"reliability"`
+	Terms        *matrix.LinguisticSummary `json:"terms,omitempty" db:"terms"`
+	SensAnalysis lib.SensitivityResult     `json:"sens_analysis" db:"sens_analysis"`
+	Threshold    float64                   `json:"threshold" db:"threshold"`
+	LastChange   time.Time                 `json:"last_change" db:"last_change"`
+}
+
+// unitCoeffs are the methods whose coefficients lie in the unit interval with
+// larger values better: closeness of TOPSIS, appraisal scores of EDAS, utility
+// degrees of ARAS and grey relational grades of GRA.
+var unitCoeffs = map[v.Method]bool{
+	v.TOPSIS: true,
+	v.EDAS:   true,
+	v.ARAS:   true,
+	v.GRA:    true,
 }
 
 func CalcFinal(matrices []MatrixModel, task *TaskModel, threshold float64) (*FinalModel, error) {
 	settings := lib.CalcSettings{}
 	settings.Parse(task.CalcSettings)
+	settings.Theta = task.Theta
+	settings.OptimisticHesitance = task.Optimistic
 	mxs := ConvertModelToMatrix(matrices, task.Criteria)
 	if mxs == nil {
 		return nil, errors.New("incompatible sizes of matrices and criteria")
 	}
+	if err := UnifyScales(matrices, mxs, &task.LingScale); err != nil {
+		return nil, err
+	}
 	weights := ConvertRatingsToEvaluated(task.ExpertsWeights)
-
+	reliability := matrix.AlternativesReliability(mxs)
 	var err error
-	var coeffs matrix.RankedList
-	if task.Method == v.TOPSIS {
-		coeffs, err = lib.TopsisFullCalc(settings, mxs, weights)
+	var terms *matrix.LinguisticSummary
+	if len(task.LingScale.Marks) != 0 {
+		terms, err = matrix.AggregateTerms(&task.LingScale, mxs, weights)
 		if err != nil {
 			return nil, err
 		}
-	} else if task.Method == v.SMART {
-		coeffs, err = lib.SmartFullCalc(settings, mxs, weights)
-		if err != nil {
+	}
+
+	final, err := lib.FullCalc(v.Method(task.Method), settings, mxs, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	if terms != nil {
+		if err = terms.SetCoeffs(&task.LingScale, final.Result, unitCoeffs[v.Method(task.Method)]); err != nil {
 			return nil, err
 		}
-	} else {
-		return nil, errors.New("invalid method of task")
 	}
 
-	sens, err := lib.SensAnalysis(v.Method(task.Method), task.CalcSettings, threshold, mxs, task.ExpertsWeights)
+	sens, err := lib.SensAnalysis(v.Method(task.Method), settings, threshold, mxs, task.ExpertsWeights)
 	if err != nil {
 		return nil, err
 	}
 
 	result := FinalModel{
 		FID:          task.SID,
-		Result:       coeffs,
+		Result:       final.Result,
+		Acceptance:   final.Acceptance,
+		PartialOrder: final.PartialOrder,
+		SubRankings:  final.SubRankings,
+		Reliability:  reliability,
+		Terms:        terms,
 		SensAnalysis: *sens,
 		Threshold:    threshold,
 		LastChange:   time.Now(),
@@ -65,6 +95,9 @@ func ConvertModelToMatrix(models []MatrixModel, criteria Criteria) []matrix.Matr
 			if err := mxs[i].SetCriterion(criteria[j].Weight, criteria[j].TypeOfCriterion, j); err != nil {
 				return nil
 			}
+			if err := mxs[i].SetThresholds(criteria[j].Thresholds, j); err != nil {
+				return nil
+			}
 		}
 	}
 	return mxs
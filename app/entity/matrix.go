@@ -1,13 +1,36 @@
 package entity
 
 import (
+	"webApp/lib/bwm"
+	"webApp/lib/eval"
 	"webApp/lib/matrix"
 )
 
 type MatrixModel struct {
-	MID    uint64         `bson:"_id"`
-	SID    uint64         `bson:"sid"`
-	UID    uint64         `bson:"uid"`
-	Matrix *matrix.Matrix `bson:"matrix"`
-	Status bool           `bson:"status"`
+	MID       uint64                `bson:"_id"`
+	SID       uint64                `bson:"sid"`
+	UID       uint64                `bson:"uid"`
+	Matrix    *matrix.Matrix        `bson:"matrix"`
+	BWM       *bwm.Comparisons      `bson:"bwm"`
+	LingScale *eval.LinguisticScale `bson:"ling_scale" db:"ling_scale"`
+	Status    bool                  `bson:"status"`
+}
+
+// UnifyScales brings ratings of experts who use their own linguistic scales
+// to the scale of the task, which serves as the basic linguistic term set.
+func UnifyScales(models []MatrixModel, mxs []matrix.Matrix, base *eval.LinguisticScale) error {
+	for i := range models {
+		if models[i].LingScale == nil {
+			continue
+		}
+
+		if err := eval.CheckScales(base, models[i].LingScale); err != nil {
+			return err
+		}
+
+		if err := mxs[i].UnifyScale(models[i].LingScale, base); err != nil {
+			return err
+		}
+	}
+	return nil
 }
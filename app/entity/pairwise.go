@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"errors"
+	"webApp/lib/ahp"
+	"webApp/lib/bwm"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+type PairwiseModel struct {
+	Comparisons      [][]eval.Number `json:"comparisons,omitempty"`
+	FuzzyComparisons [][]*eval.T1FS  `json:"fuzzy_comparisons,omitempty"`
+	Method           v.Variants      `json:"method"`
+	Force            bool            `json:"force"`
+}
+
+type PairwiseResult struct {
+	Weights []eval.Rating `json:"weights"`
+	ahp.Consistency
+}
+
+func (p *PairwiseModel) Size() int {
+	if p.Method == v.BuckleyMethod || p.Method == v.ChangMethod {
+		return len(p.FuzzyComparisons)
+	}
+	return len(p.Comparisons)
+}
+
+func CalcPairwise(model *PairwiseModel) (*PairwiseResult, error) {
+	result := &PairwiseResult{Weights: make([]eval.Rating, model.Size())}
+
+	if model.Method == v.BuckleyMethod || model.Method == v.ChangMethod {
+		fm, err := ahp.ConvertToFuzzyPairwiseMatrix(model.FuzzyComparisons)
+		if err != nil {
+			return nil, err
+		}
+
+		weights, consistency, err := fm.Weights(model.Method)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range weights {
+			result.Weights[i] = eval.Rating{Evaluated: weights[i]}
+		}
+		result.Consistency = consistency
+	} else {
+		pm, err := ahp.ConvertToPairwiseMatrix(model.Comparisons)
+		if err != nil {
+			return nil, err
+		}
+
+		weights, consistency, err := pm.Weights(model.Method)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range weights {
+			result.Weights[i] = eval.Rating{Evaluated: weights[i]}
+		}
+		result.Consistency = consistency
+	}
+	return result, nil
+}
+
+func AggregateBWM(matrices []MatrixModel, expertsWeights Weights) ([]eval.Rating, error) {
+	results := make([]bwm.Result, 0, len(matrices))
+	weights := make([]eval.Evaluated, 0, len(matrices))
+	for i := range matrices {
+		if matrices[i].BWM == nil {
+			continue
+		}
+
+		result, err := matrices[i].BWM.Weights()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+
+		if len(expertsWeights) == len(matrices) {
+			weights = append(weights, expertsWeights[i].Evaluated)
+		} else {
+			weights = append(weights, eval.Number(1))
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, errors.New("no experts submitted best-worst comparisons")
+	}
+
+	aggregated, err := bwm.AggregateWeights(results, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]eval.Rating, len(aggregated))
+	for j := range aggregated {
+		ratings[j] = eval.Rating{Evaluated: aggregated[j]}
+	}
+	return ratings, nil
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"time"
 	"webApp/lib"
+	"webApp/lib/dematel"
 	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	"webApp/lib/todim"
 	v "webApp/lib/variables"
 )
 
@@ -28,10 +31,13 @@ type TaskModel struct {
 	TaskType       string               `json:"task_type" db:"task_type"`
 	Method         string               `json:"method" db:"method"`
 	CalcSettings   int64                `json:"calc_settings" db:"calc_settings"`
+	Theta          float64              `json:"theta" db:"theta"`
+	Optimistic     bool                 `json:"optimistic_hesitance" db:"optimistic_hesitance"`
 	LingScale      eval.LinguisticScale `json:"ling_scale" db:"ling_scale"`
 	Alternatives   Alts                 `json:"alternatives" db:"alternatives"`
 	Criteria       Criteria             `json:"criteria" db:"criteria"`
 	ExpertsWeights Weights              `json:"experts_weights" db:"experts_weights"`
+	Dematel        *dematel.Influence   `json:"dematel,omitempty" db:"dematel"`
 	Status         bool                 `json:"status" db:"status"`
 }
 
@@ -54,6 +60,7 @@ func GetDefaultTask(title string, uid int64) TaskModel {
 		TaskType:     Individual,
 		Method:       v.SMART,
 		CalcSettings: settings.Comprise(),
+		Theta:        todim.DefaultTheta,
 		LingScale:    *eval.DefaultNumberScale,
 		Status:       Draft,
 	}
@@ -121,18 +128,20 @@ func (a *Alts) Scan(src interface{}) error {
 }
 
 type CriterionModel struct {
-	Title           string      `json:"title"`
-	Description     string      `json:"description"`
-	Weight          eval.Rating `json:"weight"`
-	TypeOfCriterion bool        `json:"type_of_criterion"`
+	Title           string            `json:"title"`
+	Description     string            `json:"description"`
+	Weight          eval.Rating       `json:"weight"`
+	TypeOfCriterion bool              `json:"type_of_criterion"`
+	Thresholds      matrix.Thresholds `json:"thresholds"`
 }
 
 func (c *CriterionModel) UnmarshalJSON(data []byte) error {
 	result := struct {
-		Title           *string      `json:"title"`
-		Description     string       `json:"description"`
-		Weight          *eval.Rating `json:"weight"`
-		TypeOfCriterion *bool        `json:"type_of_criterion"`
+		Title           *string            `json:"title"`
+		Description     string             `json:"description"`
+		Weight          *eval.Rating       `json:"weight"`
+		TypeOfCriterion *bool              `json:"type_of_criterion"`
+		Thresholds      *matrix.Thresholds `json:"thresholds"`
 	}{}
 
 	if err := json.Unmarshal(data, &result); err != nil {
@@ -145,6 +154,9 @@ func (c *CriterionModel) UnmarshalJSON(data []byte) error {
 		c.Description = result.Description
 		c.Weight = *result.Weight
 		c.TypeOfCriterion = *result.TypeOfCriterion
+		if result.Thresholds != nil {
+			c.Thresholds = *result.Thresholds
+		}
 	}
 	return nil
 }
@@ -0,0 +1,135 @@
+package ahp
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"testing"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		data        [][]eval.Number
+		method      v.Variants
+		weights     []eval.Number
+		ratio       eval.Number
+		consistency bool
+	}{
+		{
+			data:        [][]eval.Number{{1, 3, 5}, {1. / 3, 1, 3}, {1. / 5, 1. / 3, 1}},
+			method:      v.EigenvectorMethod,
+			weights:     []eval.Number{0.637, 0.258, 0.105},
+			ratio:       0.033,
+			consistency: true,
+		},
+		{
+			data:        [][]eval.Number{{1, 3, 5}, {1. / 3, 1, 3}, {1. / 5, 1. / 3, 1}},
+			method:      v.GeometricMeanMethod,
+			weights:     []eval.Number{0.637, 0.258, 0.105},
+			ratio:       0.033,
+			consistency: true,
+		},
+		{
+			data:        [][]eval.Number{{1, 9, 1. / 9}, {1. / 9, 1, 9}, {9, 1. / 9, 1}},
+			method:      v.EigenvectorMethod,
+			weights:     []eval.Number{0.333, 0.333, 0.333},
+			ratio:       6.130,
+			consistency: false,
+		},
+		{
+			data:        [][]eval.Number{{1, 2}, {0.5, 1}},
+			method:      v.GeometricMeanMethod,
+			weights:     []eval.Number{0.667, 0.333},
+			ratio:       0,
+			consistency: true,
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			pm, err := ConvertToPairwiseMatrix(tt.data)
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			weights, consistency, err := pm.Weights(tt.method)
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			fmt.Println(weights, consistency)
+			for i := range weights {
+				if math.Abs(float64(weights[i]-tt.weights[i])) > 0.01 {
+					t.Errorf("got %f, want %f\n", weights[i], tt.weights[i])
+				}
+			}
+			if math.Abs(float64(consistency.Ratio-tt.ratio)) > 0.01 || consistency.IsAcceptable() != tt.consistency {
+				t.Errorf("got ratio %f, want %f\n", consistency.Ratio, tt.ratio)
+			}
+		})
+	}
+
+	if _, err := ConvertToPairwiseMatrix([][]eval.Number{{1, 3}, {3, 1}}); err == nil {
+		t.Errorf("expected error for non-reciprocal matrix")
+	}
+}
+
+func TestFuzzyTableDriven(t *testing.T) {
+	data := [][]*eval.T1FS{
+		{eval.NewT1FS(1, 1, 1), eval.NewT1FS(2, 3, 4), eval.NewT1FS(4, 5, 6)},
+		{eval.NewT1FS(1./4, 1./3, 1./2), eval.NewT1FS(1, 1, 1), eval.NewT1FS(1, 2, 3)},
+		{eval.NewT1FS(1./6, 1./5, 1./4), eval.NewT1FS(1./3, 1./2, 1), eval.NewT1FS(1, 1, 1)},
+	}
+
+	var tests = []struct {
+		data        [][]*eval.T1FS
+		method      v.Variants
+		weights     [][]eval.Number
+		consistency bool
+	}{
+		{
+			data:        data,
+			method:      v.BuckleyMethod,
+			weights:     [][]eval.Number{{0.429, 0.648, 0.958}, {0.135, 0.230, 0.380}, {0.082, 0.122, 0.209}},
+			consistency: true,
+		},
+		{
+			data:        data,
+			method:      v.ChangMethod,
+			weights:     [][]eval.Number{{0.945, 0.945, 0.945}, {0.055, 0.055, 0.055}, {0, 0, 0}},
+			consistency: true,
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			fm, err := ConvertToFuzzyPairwiseMatrix(tt.data)
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			weights, consistency, err := fm.Weights(tt.method)
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			fmt.Println(weights, consistency)
+			for i := range weights {
+				for k := range weights[i].Vert {
+					if math.Abs(float64(weights[i].Vert[k]-tt.weights[i][k])) > 0.01 {
+						t.Errorf("got %f, want %f\n", weights[i].Vert[k], tt.weights[i][k])
+					}
+				}
+			}
+			if consistency.IsAcceptable() != tt.consistency {
+				t.Errorf("got ratio %f\n", consistency.Ratio)
+			}
+		})
+	}
+}
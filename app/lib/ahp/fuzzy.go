@@ -0,0 +1,189 @@
+package ahp
+
+import (
+	"math"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+type FuzzyPairwiseMatrix struct {
+	Size int            `json:"size"`
+	Data [][]*eval.T1FS `json:"data"`
+}
+
+func NewFuzzyPairwiseMatrix(size int) *FuzzyPairwiseMatrix {
+	data := make([][]*eval.T1FS, size)
+	for i := range data {
+		data[i] = make([]*eval.T1FS, size)
+		data[i][i] = eval.NewT1FS(1, 1, 1)
+	}
+	return &FuzzyPairwiseMatrix{Size: size, Data: data}
+}
+
+func ConvertToFuzzyPairwiseMatrix(data [][]*eval.T1FS) (*FuzzyPairwiseMatrix, error) {
+	fm := NewFuzzyPairwiseMatrix(len(data))
+	for i := range data {
+		if len(data[i]) != fm.Size {
+			return nil, v.InvalidSize
+		}
+		for j := range data[i] {
+			if data[i][j] == nil || len(data[i][j].Vert) != 3 {
+				return nil, v.IncompatibleTypes
+			}
+			fm.Data[i][j] = eval.NewT1FS(data[i][j].Vert...)
+		}
+	}
+	return fm, fm.Validate()
+}
+
+func (fm *FuzzyPairwiseMatrix) SetComparison(value *eval.T1FS, i, j int) error {
+	if i < 0 || j < 0 || i >= fm.Size || j >= fm.Size {
+		return v.OutOfBounds
+	}
+
+	if value == nil || len(value.Vert) != 3 {
+		return v.IncompatibleTypes
+	}
+
+	l, m, u := value.Vert[0], value.Vert[1], value.Vert[2]
+	if !onScale(l) || !onScale(u) || l > m || m > u {
+		return v.InvalidCaseOfOperation
+	}
+
+	fm.Data[i][j] = eval.NewT1FS(l, m, u)
+	fm.Data[j][i] = eval.NewT1FS(1/u, 1/m, 1/l)
+	return nil
+}
+
+func (fm *FuzzyPairwiseMatrix) Validate() error {
+	if fm.Size == 0 {
+		return v.EmptyValues
+	}
+
+	for i := range fm.Data {
+		for j := range fm.Data[i] {
+			a, b := fm.Data[i][j].Vert, fm.Data[j][i].Vert
+			if !onScale(a[0]) || !onScale(a[2]) || a[0] > a[1] || a[1] > a[2] {
+				return v.InvalidCaseOfOperation
+			}
+
+			for k := range a {
+				if math.Abs(float64(a[k]*b[len(b)-k-1]-1)) > 1e-3 {
+					return v.InvalidCaseOfOperation
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (fm *FuzzyPairwiseMatrix) BuckleyWeights() []*eval.T1FS {
+	means := make([][]eval.Number, fm.Size)
+	sum := make([]eval.Number, 3)
+	for i := range fm.Data {
+		means[i] = []eval.Number{1, 1, 1}
+		for j := range fm.Data[i] {
+			for k := range means[i] {
+				means[i][k] *= fm.Data[i][j].Vert[k]
+			}
+		}
+
+		for k := range means[i] {
+			means[i][k] = eval.Number(math.Pow(float64(means[i][k]), 1/float64(fm.Size)))
+			sum[k] += means[i][k]
+		}
+	}
+
+	weights := make([]*eval.T1FS, fm.Size)
+	for i := range weights {
+		weights[i] = eval.NewT1FS(means[i][0]/sum[2], means[i][1]/sum[1], means[i][2]/sum[0])
+	}
+	return weights
+}
+
+func possibility(a, b []eval.Number) eval.Number {
+	if a[1] >= b[1] {
+		return 1
+	} else if b[0] >= a[2] {
+		return 0
+	}
+	return (b[0] - a[2]) / ((a[1] - a[2]) - (b[1] - b[0]))
+}
+
+func (fm *FuzzyPairwiseMatrix) SyntheticExtents() []*eval.T1FS {
+	rows := make([][]eval.Number, fm.Size)
+	total := make([]eval.Number, 3)
+	for i := range fm.Data {
+		rows[i] = make([]eval.Number, 3)
+		for j := range fm.Data[i] {
+			for k := range rows[i] {
+				rows[i][k] += fm.Data[i][j].Vert[k]
+			}
+		}
+
+		for k := range rows[i] {
+			total[k] += rows[i][k]
+		}
+	}
+
+	extents := make([]*eval.T1FS, fm.Size)
+	for i := range extents {
+		extents[i] = eval.NewT1FS(rows[i][0]/total[2], rows[i][1]/total[1], rows[i][2]/total[0])
+	}
+	return extents
+}
+
+func (fm *FuzzyPairwiseMatrix) ChangWeights() []*eval.T1FS {
+	extents := fm.SyntheticExtents()
+	degrees := make([]eval.Number, fm.Size)
+	sum := eval.Number(0)
+	for i := range extents {
+		degrees[i] = 1
+		for k := range extents {
+			if i != k {
+				degrees[i] = min(degrees[i], possibility(extents[i].Vert, extents[k].Vert))
+			}
+		}
+		sum += degrees[i]
+	}
+
+	weights := make([]*eval.T1FS, fm.Size)
+	for i := range weights {
+		w := 1 / eval.Number(fm.Size)
+		if sum != 0 {
+			w = degrees[i] / sum
+		}
+		weights[i] = eval.NewT1FS(w, w, w)
+	}
+	return weights
+}
+
+func (fm *FuzzyPairwiseMatrix) Consistency() Consistency {
+	middle := NewPairwiseMatrix(fm.Size)
+	bounds := NewPairwiseMatrix(fm.Size)
+	for i := range fm.Data {
+		for j := range fm.Data[i] {
+			middle.Data[i][j] = fm.Data[i][j].Vert[1]
+			bounds.Data[i][j] = eval.Number(math.Sqrt(float64(fm.Data[i][j].Vert[0] * fm.Data[i][j].Vert[2])))
+		}
+	}
+
+	middleConsistency := middle.Consistency(middle.EigenvectorWeights())
+	boundsConsistency := bounds.Consistency(bounds.EigenvectorWeights())
+	if boundsConsistency.Ratio > middleConsistency.Ratio {
+		return boundsConsistency
+	}
+	return middleConsistency
+}
+
+func (fm *FuzzyPairwiseMatrix) Weights(method v.Variants) ([]*eval.T1FS, Consistency, error) {
+	var weights []*eval.T1FS
+	if method == v.BuckleyMethod {
+		weights = fm.BuckleyWeights()
+	} else if method == v.ChangMethod {
+		weights = fm.ChangWeights()
+	} else {
+		return nil, Consistency{}, v.InvalidCaseOfOperation
+	}
+	return weights, fm.Consistency(), nil
+}
@@ -0,0 +1,172 @@
+package ahp
+
+import (
+	"math"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+const (
+	ConsistencyThreshold = 0.1
+	MaxIterations        = 1000
+	Precision            = 1e-10
+)
+
+var randomIndexes = []eval.Number{0, 0, 0, 0.58, 0.9, 1.12, 1.24, 1.32, 1.41, 1.45, 1.49, 1.51, 1.48, 1.56, 1.57, 1.59}
+
+type PairwiseMatrix struct {
+	Size int             `json:"size"`
+	Data [][]eval.Number `json:"data"`
+}
+
+type Consistency struct {
+	LambdaMax eval.Number `json:"lambda_max"`
+	Index     eval.Number `json:"consistency_index"`
+	Ratio     eval.Number `json:"consistency_ratio"`
+}
+
+func (c Consistency) IsAcceptable() bool {
+	return c.Ratio <= ConsistencyThreshold
+}
+
+func NewPairwiseMatrix(size int) *PairwiseMatrix {
+	data := make([][]eval.Number, size)
+	for i := range data {
+		data[i] = make([]eval.Number, size)
+		data[i][i] = 1
+	}
+	return &PairwiseMatrix{Size: size, Data: data}
+}
+
+func ConvertToPairwiseMatrix(data [][]eval.Number) (*PairwiseMatrix, error) {
+	pm := NewPairwiseMatrix(len(data))
+	for i := range data {
+		if len(data[i]) != pm.Size {
+			return nil, v.InvalidSize
+		}
+		copy(pm.Data[i], data[i])
+	}
+	return pm, pm.Validate()
+}
+
+func onScale(value eval.Number) bool {
+	return value >= 1./9-Precision && value <= 9+Precision
+}
+
+func (pm *PairwiseMatrix) SetComparison(value eval.Number, i, j int) error {
+	if i < 0 || j < 0 || i >= pm.Size || j >= pm.Size {
+		return v.OutOfBounds
+	}
+
+	if !onScale(value) || (i == j && value != 1) {
+		return v.InvalidCaseOfOperation
+	}
+
+	pm.Data[i][j] = value
+	pm.Data[j][i] = 1 / value
+	return nil
+}
+
+func (pm *PairwiseMatrix) Validate() error {
+	if pm.Size == 0 {
+		return v.EmptyValues
+	}
+
+	for i := range pm.Data {
+		if len(pm.Data[i]) != pm.Size {
+			return v.InvalidSize
+		}
+		for j := range pm.Data[i] {
+			if !onScale(pm.Data[i][j]) || math.Abs(float64(pm.Data[i][j]*pm.Data[j][i]-1)) > 1e-3 {
+				return v.InvalidCaseOfOperation
+			}
+		}
+	}
+	return nil
+}
+
+func (pm *PairwiseMatrix) EigenvectorWeights() []eval.Number {
+	weights := make([]eval.Number, pm.Size)
+	for i := range weights {
+		weights[i] = 1 / eval.Number(pm.Size)
+	}
+
+	for k := 0; k < MaxIterations; k++ {
+		next := make([]eval.Number, pm.Size)
+		sum := eval.Number(0)
+		for i := range pm.Data {
+			for j := range pm.Data[i] {
+				next[i] += pm.Data[i][j] * weights[j]
+			}
+			sum += next[i]
+		}
+
+		diff := eval.Number(0)
+		for i := range next {
+			next[i] /= sum
+			diff = max(diff, eval.Number(math.Abs(float64(next[i]-weights[i]))))
+		}
+
+		weights = next
+		if diff < Precision {
+			break
+		}
+	}
+	return weights
+}
+
+func (pm *PairwiseMatrix) GeometricMeanWeights() []eval.Number {
+	weights := make([]eval.Number, pm.Size)
+	sum := eval.Number(0)
+	for i := range pm.Data {
+		product := 1.
+		for j := range pm.Data[i] {
+			product *= float64(pm.Data[i][j])
+		}
+		weights[i] = eval.Number(math.Pow(product, 1/float64(pm.Size)))
+		sum += weights[i]
+	}
+
+	for i := range weights {
+		weights[i] /= sum
+	}
+	return weights
+}
+
+func RandomIndex(size int) eval.Number {
+	if size < len(randomIndexes) {
+		return randomIndexes[size]
+	}
+	return randomIndexes[len(randomIndexes)-1]
+}
+
+func (pm *PairwiseMatrix) Consistency(weights []eval.Number) Consistency {
+	lambda := eval.Number(0)
+	for i := range pm.Data {
+		row := eval.Number(0)
+		for j := range pm.Data[i] {
+			row += pm.Data[i][j] * weights[j]
+		}
+		lambda += row / weights[i]
+	}
+	lambda /= eval.Number(pm.Size)
+
+	result := Consistency{LambdaMax: lambda}
+	if pm.Size > 2 {
+		result.Index = (lambda - eval.Number(pm.Size)) / eval.Number(pm.Size-1)
+		result.Ratio = result.Index / RandomIndex(pm.Size)
+	}
+	return result
+}
+
+func (pm *PairwiseMatrix) Weights(method v.Variants) ([]eval.Number, Consistency, error) {
+	var weights []eval.Number
+	if method == v.EigenvectorMethod {
+		weights = pm.EigenvectorWeights()
+	} else if method == v.GeometricMeanMethod {
+		weights = pm.GeometricMeanWeights()
+	} else {
+		return nil, Consistency{}, v.InvalidCaseOfOperation
+	}
+	return weights, pm.Consistency(weights), nil
+}
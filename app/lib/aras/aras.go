@@ -0,0 +1,99 @@
+package aras
+
+import (
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+// FindOptimal normalizes the benefit-oriented ratings by the sum of each
+// column together with its optimal value, the best rating of the column.
+func (am *ArasMatrix) FindOptimal() error {
+	oriented, err := am.BenefitOriented()
+	if err != nil {
+		return err
+	}
+
+	for j := range am.Criteria {
+		am.Optimal[j] = oriented[0][j]
+		for i := range oriented {
+			am.Optimal[j] = max(am.Optimal[j], oriented[i][j])
+		}
+
+		sum := am.Optimal[j]
+		for i := range oriented {
+			sum += oriented[i][j]
+		}
+		if sum == 0 {
+			return v.EmptyValues
+		}
+
+		for i := range oriented {
+			am.Normalized[i][j] = oriented[i][j] / sum
+		}
+		am.Optimal[j] /= sum
+	}
+	am.OptimalFind = true
+	return nil
+}
+
+func (am *ArasMatrix) CalcUtilities(g int) error {
+	var wg sync.WaitGroup
+
+	optimal := eval.Number(0)
+	weights := make([]eval.Number, am.CountCriteria)
+	for j, c := range am.Criteria {
+		weights[j] = eval.Crisp(c.Weight, am.Defuzzification)
+		optimal += weights[j] * am.Optimal[j]
+	}
+	if optimal == 0 {
+		return v.EmptyValues
+	}
+
+	if g > am.CountAlternatives {
+		g = am.CountAlternatives
+	}
+	off := am.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = am.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				utility := eval.Number(0)
+				for j := 0; j < am.CountCriteria; j++ {
+					utility += weights[j] * am.Normalized[i][j]
+				}
+				am.Utilities[i] = eval.Rating{Evaluated: utility / optimal}
+			}
+		}(b)
+	}
+	wg.Wait()
+	am.UtilityFind = true
+	return nil
+}
+
+func (am *ArasMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(am.Utilities))
+	ind := make([]int, len(am.Utilities))
+	for i := range set {
+		ind[i] = i
+		set[i] = am.Utilities[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], am.Defuzzification) > eval.Crisp(set[ind[j]], am.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], am.Defuzzification) > eval.Crisp(set[j], am.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
@@ -0,0 +1,122 @@
+package aras
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func ArasCalculating(arasMatrix *ArasMatrix, weightNorm v.Variants) ([]eval.Rating, error) {
+	if err := arasMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, err
+	}
+
+	if err := arasMatrix.FindOptimal(); err != nil {
+		return nil, err
+	}
+
+	if err := arasMatrix.CalcUtilities(5); err != nil {
+		return nil, err
+	}
+
+	return arasMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *ArasMatrix
+		weightNorm v.Variants
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToArasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			resultRow:  []eval.Number{0.786, 0.704, 0.420, 0.454},
+		},
+		{
+			initMat:    ConvertToArasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{0.636, 0.723, 0.525},
+		},
+		{
+			initMat:    ConvertToArasMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{0.393, 0.440, 0.640, 0.701},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := ArasCalculating(tt.initMat, tt.weightNorm); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCostCriterion(t *testing.T) {
+	var tests = []struct {
+		ratings   [][]eval.Number
+		types     []bool
+		resultRow []eval.Number
+		order     []int
+	}{
+		{
+			ratings:   [][]eval.Number{{1}, {5}, {9}},
+			types:     []bool{v.Cost},
+			resultRow: []eval.Number{1, 0.2, 0.111},
+			order:     []int{0, 1, 2},
+		},
+		{
+			ratings:   [][]eval.Number{{4, 1}, {2, 5}, {8, 9}},
+			types:     []bool{v.Benefit, v.Cost},
+			resultRow: []eval.Number{0.772, 0.223, 0.517},
+			order:     []int{0, 2, 1},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			m := NewArasMatrix(len(tt.ratings), len(tt.types))
+			for k, row := range tt.ratings {
+				for j := range row {
+					_ = m.SetValue(row[j], k, j)
+				}
+			}
+			for j, typeOf := range tt.types {
+				_ = m.SetCriterion(eval.Number(1), typeOf, j)
+			}
+
+			if res, err := ArasCalculating(m, v.NormalizeWithSum); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+
+				if list := m.RankedList(); !reflect.DeepEqual(list.Order, tt.order) {
+					t.Errorf("got %v, want %v", list.Order, tt.order)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package aras
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type ArasMatrix struct {
+	*matrix.Matrix
+	Normalized  [][]eval.Number `json:"normalized"`
+	Optimal     []eval.Number   `json:"optimal"`
+	OptimalFind bool            `json:"is_optimal_find"`
+	Utilities   []eval.Rating   `json:"utilities"`
+	UtilityFind bool            `json:"is_utility_find"`
+}
+
+func NewArasMatrix(x, y int) *ArasMatrix {
+	return &ArasMatrix{
+		Matrix:     matrix.NewMatrix(x, y),
+		Normalized: newNormalized(x, y),
+		Optimal:    make([]eval.Number, y),
+		Utilities:  make([]eval.Rating, x),
+	}
+}
+
+func ConvertToArasMatrix(m *matrix.Matrix) *ArasMatrix {
+	return &ArasMatrix{
+		Matrix:     matrix.CopyMatrix(m),
+		Normalized: newNormalized(m.CountAlternatives, m.CountCriteria),
+		Optimal:    make([]eval.Number, m.CountCriteria),
+		Utilities:  make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func newNormalized(x, y int) [][]eval.Number {
+	normalized := make([][]eval.Number, x)
+	for i := range normalized {
+		normalized[i] = make([]eval.Number, y)
+	}
+	return normalized
+}
+
+func (am *ArasMatrix) GetCoefs() []eval.Rating {
+	return am.Utilities
+}
+
+func (am *ArasMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range am.Data {
+		s += am.Data[i].String() + "\n"
+	}
+
+	if am.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < am.CountCriteria; i++ {
+			s += am.Criteria[i].String() + " "
+		}
+	}
+
+	if am.OptimalFind {
+		s += "\nOptimal alternative:\n"
+		for j := 0; j < am.CountCriteria; j++ {
+			s += am.Optimal[j].String() + " "
+		}
+	}
+
+	if am.UtilityFind {
+		s += "\nUtility degrees:\n"
+		for i := 0; i < am.CountAlternatives; i++ {
+			s += am.Utilities[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateUtilities(matrices []ArasMatrix, weights []eval.Evaluated) (*ArasMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewArasMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i, utility := range matrices[k].Utilities {
+			if k == 0 {
+				result.Utilities[i] = utility.Weighted(weights[k])
+				continue
+			}
+			result.Utilities[i] = result.Utilities[i].Sum(utility.Weighted(weights[k]))
+		}
+	}
+	result.UtilityFind = true
+	return result, nil
+}
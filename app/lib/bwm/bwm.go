@@ -0,0 +1,258 @@
+package bwm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+var consistencyIndexes = []eval.Number{0, 0, 0.44, 1, 1.63, 2.3, 3, 3.73, 4.47, 5.23}
+
+type Comparisons struct {
+	Best          int           `json:"best"`
+	Worst         int           `json:"worst"`
+	BestToOthers  []eval.Number `json:"best_to_others"`
+	OthersToWorst []eval.Number `json:"others_to_worst"`
+	Method        v.Variants    `json:"method"`
+}
+
+type Result struct {
+	Weights []eval.Number `json:"weights"`
+	Xi      eval.Number   `json:"xi"`
+	Ratio   eval.Number   `json:"consistency_ratio"`
+}
+
+func (c Comparisons) Value() (driver.Value, error) {
+	data, err := json.Marshal(c)
+	return string(data), err
+}
+
+func (c *Comparisons) Scan(src interface{}) error {
+	var tmp Comparisons
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for Comparisons")
+	}
+	if err != nil {
+		return err
+	}
+	*c = tmp
+	return nil
+}
+
+func (c *Comparisons) Size() int {
+	return len(c.BestToOthers)
+}
+
+func (c *Comparisons) Validate() error {
+	n := len(c.BestToOthers)
+	if n == 0 {
+		return v.EmptyValues
+	}
+
+	if len(c.OthersToWorst) != n {
+		return v.InvalidSize
+	}
+
+	if c.Best < 0 || c.Worst < 0 || c.Best >= n || c.Worst >= n {
+		return v.OutOfBounds
+	}
+
+	for j := 0; j < n; j++ {
+		if c.BestToOthers[j] < 1 || c.BestToOthers[j] > 9 || c.OthersToWorst[j] < 1 || c.OthersToWorst[j] > 9 {
+			return v.InvalidCaseOfOperation
+		}
+	}
+
+	if c.BestToOthers[c.Best] != 1 || c.OthersToWorst[c.Worst] != 1 || c.BestToOthers[c.Worst] != c.OthersToWorst[c.Best] {
+		return v.InvalidCaseOfOperation
+	}
+	return nil
+}
+
+func ConsistencyIndex(bestToWorst eval.Number) eval.Number {
+	i := int(math.Round(float64(bestToWorst)))
+	if i < 0 {
+		return 0
+	} else if i >= len(consistencyIndexes) {
+		return consistencyIndexes[len(consistencyIndexes)-1]
+	}
+	return consistencyIndexes[i]
+}
+
+func (c *Comparisons) LinearWeights() ([]eval.Number, eval.Number, error) {
+	n := c.Size()
+	objective := make([]float64, n+1)
+	objective[n] = 1
+
+	constraints := make([][]float64, 0, 4*n)
+	bound := func(upper, lower int, ratio eval.Number) {
+		row := make([]float64, n+1)
+		row[upper] += 1
+		row[lower] -= float64(ratio)
+		row[n] = -1
+		constraints = append(constraints, row)
+
+		row = make([]float64, n+1)
+		row[upper] -= 1
+		row[lower] += float64(ratio)
+		row[n] = -1
+		constraints = append(constraints, row)
+	}
+
+	for j := 0; j < n; j++ {
+		bound(c.Best, j, c.BestToOthers[j])
+		bound(j, c.Worst, c.OthersToWorst[j])
+	}
+
+	sum := make([]float64, n+1)
+	for j := 0; j < n; j++ {
+		sum[j] = 1
+	}
+
+	x, err := simplex(objective, constraints, sum)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	weights := make([]eval.Number, n)
+	for j := range weights {
+		weights[j] = eval.Number(x[j])
+	}
+	return weights, eval.Number(x[n]), nil
+}
+
+type edge struct {
+	from, to int
+	weight   float64
+}
+
+func (c *Comparisons) feasible(xi float64) ([]float64, bool) {
+	n := c.Size()
+	edges := make([]edge, 0, 4*n)
+	ratio := func(upper, lower int, a eval.Number) {
+		edges = append(edges, edge{from: lower, to: upper, weight: math.Log(float64(a) + xi)})
+		if float64(a)-xi > 0 {
+			edges = append(edges, edge{from: upper, to: lower, weight: -math.Log(float64(a) - xi)})
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		ratio(c.Best, j, c.BestToOthers[j])
+		ratio(j, c.Worst, c.OthersToWorst[j])
+	}
+
+	dist := make([]float64, n)
+	for k := 0; k <= n; k++ {
+		changed := false
+		for _, e := range edges {
+			if dist[e.from]+e.weight < dist[e.to]-epsilon {
+				dist[e.to] = dist[e.from] + e.weight
+				changed = true
+			}
+		}
+
+		if !changed {
+			return dist, true
+		}
+	}
+	return nil, false
+}
+
+func (c *Comparisons) NonlinearWeights() ([]eval.Number, eval.Number, error) {
+	low, high := 0., 0.
+	for j := 0; j < c.Size(); j++ {
+		high = math.Max(high, math.Max(float64(c.BestToOthers[j]), float64(c.OthersToWorst[j])))
+	}
+
+	logs, ok := c.feasible(high)
+	if !ok {
+		return nil, 0, v.InvalidCaseOfOperation
+	}
+
+	if exact, ok := c.feasible(0); ok {
+		logs, high = exact, 0
+	} else {
+		for k := 0; k < 100 && high-low > epsilon; k++ {
+			mid := (low + high) / 2
+			if dist, ok := c.feasible(mid); ok {
+				logs, high = dist, mid
+			} else {
+				low = mid
+			}
+		}
+	}
+
+	weights := make([]eval.Number, c.Size())
+	sum := eval.Number(0)
+	for j := range weights {
+		weights[j] = eval.Number(math.Exp(logs[j]))
+		sum += weights[j]
+	}
+
+	for j := range weights {
+		weights[j] /= sum
+	}
+	return weights, eval.Number(high), nil
+}
+
+func (c *Comparisons) Weights() (Result, error) {
+	if err := c.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	var result Result
+	var err error
+	if c.Method == v.LinearBWM {
+		result.Weights, result.Xi, err = c.LinearWeights()
+		result.Ratio = result.Xi
+	} else if c.Method == v.NonlinearBWM {
+		result.Weights, result.Xi, err = c.NonlinearWeights()
+		if ci := ConsistencyIndex(c.BestToOthers[c.Worst]); ci != 0 {
+			result.Ratio = result.Xi / ci
+		}
+	} else {
+		return Result{}, v.InvalidCaseOfOperation
+	}
+	return result, err
+}
+
+func AggregateWeights(results []Result, weights []eval.Evaluated) ([]eval.Number, error) {
+	if len(results) == 0 || len(results) != len(weights) {
+		return nil, v.InvalidSize
+	}
+
+	n := len(results[0].Weights)
+	aggregated := make([]eval.Number, n)
+	sum := eval.Number(0)
+	for k := range results {
+		if len(results[k].Weights) != n {
+			return nil, v.InvalidSize
+		}
+
+		w := weights[k].ConvertToNumber()
+		for j := range aggregated {
+			aggregated[j] += results[k].Weights[j] * w
+		}
+		sum += w
+	}
+
+	if sum == 0 {
+		return nil, v.InvalidCaseOfOperation
+	}
+
+	for j := range aggregated {
+		aggregated[j] /= sum
+	}
+	return aggregated, nil
+}
@@ -0,0 +1,67 @@
+package bwm
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"testing"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+func TestTableDriven(t *testing.T) {
+	car := Comparisons{
+		Best:          1,
+		Worst:         4,
+		BestToOthers:  []eval.Number{2, 1, 4, 2, 8},
+		OthersToWorst: []eval.Number{4, 8, 4, 4, 1},
+	}
+
+	var tests = []struct {
+		comparisons Comparisons
+		method      v.Variants
+		weights     []eval.Number
+		xi          eval.Number
+	}{
+		{
+			comparisons: car,
+			method:      v.LinearBWM,
+			weights:     []eval.Number{0.225, 0.394, 0.113, 0.225, 0.042},
+			xi:          0.056,
+		},
+		{
+			comparisons: car,
+			method:      v.NonlinearBWM,
+			weights:     []eval.Number{0.217, 0.391, 0.130, 0.217, 0.043},
+			xi:          1,
+		},
+		{
+			comparisons: Comparisons{Best: 0, Worst: 2, BestToOthers: []eval.Number{1, 2, 4}, OthersToWorst: []eval.Number{4, 2, 1}},
+			method:      v.NonlinearBWM,
+			weights:     []eval.Number{0.571, 0.286, 0.143},
+			xi:          0,
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			tt.comparisons.Method = tt.method
+			result, err := tt.comparisons.Weights()
+			if err != nil {
+				t.Fatalf(err.Error())
+			}
+
+			fmt.Println(result)
+			for j := range result.Weights {
+				if math.Abs(float64(result.Weights[j]-tt.weights[j])) > 0.01 {
+					t.Errorf("got %f, want %f\n", result.Weights[j], tt.weights[j])
+				}
+			}
+			if math.Abs(float64(result.Xi-tt.xi)) > 0.01 {
+				t.Errorf("got xi %f, want %f\n", result.Xi, tt.xi)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package bwm
+
+import (
+	"math"
+	v "webApp/lib/variables"
+)
+
+const (
+	bigM    = 1e6
+	epsilon = 1e-9
+)
+
+// simplex minimises c·x subject to ub·x <= 0, eq·x = 1 and x >= 0
+func simplex(c []float64, ub [][]float64, eq []float64) ([]float64, error) {
+	n := len(c)
+	m := len(ub) + 1
+	width := n + m + 1
+
+	tableau := make([][]float64, m+1)
+	basis := make([]int, m)
+	for i := range ub {
+		tableau[i] = make([]float64, width)
+		copy(tableau[i], ub[i])
+		tableau[i][n+i] = 1
+		basis[i] = n + i
+	}
+
+	tableau[m-1] = make([]float64, width)
+	copy(tableau[m-1], eq)
+	tableau[m-1][n+m-1] = 1
+	tableau[m-1][width-1] = 1
+	basis[m-1] = n + m - 1
+
+	tableau[m] = make([]float64, width)
+	copy(tableau[m], c)
+	for j := 0; j < width; j++ {
+		tableau[m][j] -= bigM * tableau[m-1][j]
+	}
+	tableau[m][n+m-1] = 0
+
+	for iter := 0; iter < 1000; iter++ {
+		col := -1
+		for j := 0; j < width-1; j++ {
+			if tableau[m][j] < -epsilon {
+				col = j
+				break
+			}
+		}
+		if col == -1 {
+			break
+		}
+
+		row := -1
+		best := math.Inf(1)
+		for i := 0; i < m; i++ {
+			if tableau[i][col] > epsilon {
+				if r := tableau[i][width-1] / tableau[i][col]; r < best-epsilon || (r < best+epsilon && row != -1 && basis[i] < basis[row]) {
+					best, row = r, i
+				}
+			}
+		}
+		if row == -1 {
+			return nil, v.InvalidCaseOfOperation
+		}
+
+		pivot := tableau[row][col]
+		for j := range tableau[row] {
+			tableau[row][j] /= pivot
+		}
+		for i := range tableau {
+			if i != row && tableau[i][col] != 0 {
+				factor := tableau[i][col]
+				for j := range tableau[i] {
+					tableau[i][j] -= factor * tableau[row][j]
+				}
+			}
+		}
+		basis[row] = col
+	}
+
+	x := make([]float64, n)
+	for i, b := range basis {
+		if b == n+m-1 && tableau[i][width-1] > epsilon {
+			return nil, v.InvalidCaseOfOperation
+		}
+		if b < n {
+			x[b] = tableau[i][width-1]
+		}
+	}
+	return x, nil
+}
@@ -0,0 +1,102 @@
+package codas
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const DefaultThreshold = 0.02
+
+func (cm *CodasMatrix) FindNegativeIdeal() {
+	cm.NegativeIdeal = matrix.Alternative{Grade: make([]eval.Rating, cm.CountCriteria), CountOfCriteria: cm.CountCriteria}
+	for j, c := range cm.Criteria {
+		worst := 0
+		for i := 1; i < cm.CountAlternatives; i++ {
+			value := eval.Crisp(cm.Data[i].Grade[j], cm.Defuzzification)
+			if (c.TypeOfCriteria == v.Benefit && value < eval.Crisp(cm.Data[worst].Grade[j], cm.Defuzzification)) ||
+				(c.TypeOfCriteria == v.Cost && value > eval.Crisp(cm.Data[worst].Grade[j], cm.Defuzzification)) {
+				worst = i
+			}
+		}
+		cm.NegativeIdeal.Grade[j] = cm.Data[worst].Grade[j].CopyEval()
+	}
+	cm.IdealFind = true
+}
+
+func (cm *CodasMatrix) FindDistances(vn v.Variants, g int) error {
+	var wg sync.WaitGroup
+	var err error
+	if g > cm.CountAlternatives {
+		g = cm.CountAlternatives
+	}
+	off := cm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = cm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				euclidean, taxicab := eval.Number(0), eval.Number(0)
+				for j := 0; j < cm.CountCriteria; j++ {
+					d, inerr := eval.Distance(cm.Data[i].Grade[j], cm.NegativeIdeal.Grade[j], vn)
+					if inerr != nil {
+						err = inerr
+						return
+					}
+					euclidean += d * d
+					taxicab += d
+				}
+				cm.Euclidean[i] = eval.Number(math.Sqrt(float64(euclidean)))
+				cm.Taxicab[i] = taxicab
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if err == nil {
+		cm.DistancesFind = true
+	}
+	return err
+}
+
+func (cm *CodasMatrix) CalcAssessment(threshold float64) {
+	for i := 0; i < cm.CountAlternatives; i++ {
+		h := eval.Number(0)
+		for k := 0; k < cm.CountAlternatives; k++ {
+			diff := cm.Euclidean[i] - cm.Euclidean[k]
+			h += diff
+			if math.Abs(float64(diff)) >= threshold {
+				h += cm.Taxicab[i] - cm.Taxicab[k]
+			}
+		}
+		cm.Assessment[i] = eval.Rating{Evaluated: h}
+	}
+	cm.AssessmentFind = true
+}
+
+func (cm *CodasMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(cm.Assessment))
+	ind := make([]int, len(cm.Assessment))
+	for i := range set {
+		ind[i] = i
+		set[i] = cm.Assessment[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], cm.Defuzzification) > eval.Crisp(set[ind[j]], cm.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], cm.Defuzzification) > eval.Crisp(set[j], cm.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
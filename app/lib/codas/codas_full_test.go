@@ -0,0 +1,88 @@
+package codas
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func CodasCalculating(codasMatrix *CodasMatrix, valueNorm, weightNorm, vn v.Variants, threshold float64) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(1, *codasMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	if err := codasMatrix.Normalization(valueNorm, weightNorm, 5); err != nil {
+		return nil, err
+	}
+
+	codasMatrix.CalcWeightedMatrix(5)
+
+	codasMatrix.FindNegativeIdeal()
+
+	if err := codasMatrix.FindDistances(vn, 5); err != nil {
+		return nil, err
+	}
+
+	codasMatrix.CalcAssessment(threshold)
+
+	return codasMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *CodasMatrix
+		valueNorm  v.Variants
+		weightNorm v.Variants
+		threshold  float64
+		vn         v.Variants
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToCodasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			threshold:  DefaultThreshold,
+			vn:         v.SqrtDistance,
+			resultRow:  []eval.Number{0.772, 0.441, -0.647, -0.566},
+		},
+		{
+			initMat:    ConvertToCodasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			threshold:  DefaultThreshold,
+			vn:         v.SqrtDistance,
+			resultRow:  []eval.Number{0.560, -0.698, 0.138},
+		},
+		{
+			initMat:    ConvertToCodasMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			threshold:  DefaultThreshold,
+			vn:         v.CbrtDistance,
+			resultRow:  []eval.Number{0.109, 0.642, -1.050, 0.299},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := CodasCalculating(tt.initMat, tt.valueNorm, tt.weightNorm, tt.vn, tt.threshold); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
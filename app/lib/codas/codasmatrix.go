@@ -0,0 +1,96 @@
+package codas
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type CodasMatrix struct {
+	*matrix.Matrix
+	NegativeIdeal  matrix.Alternative `json:"neg_ideal"`
+	IdealFind      bool               `json:"is_ideal_find"`
+	Euclidean      []eval.Number      `json:"euclidean"`
+	Taxicab        []eval.Number      `json:"taxicab"`
+	DistancesFind  bool               `json:"is_dist_find"`
+	Assessment     []eval.Rating      `json:"assessment"`
+	AssessmentFind bool               `json:"is_assessment_find"`
+}
+
+func NewCodasMatrix(x, y int) *CodasMatrix {
+	return &CodasMatrix{
+		Matrix:     matrix.NewMatrix(x, y),
+		Euclidean:  make([]eval.Number, x),
+		Taxicab:    make([]eval.Number, x),
+		Assessment: make([]eval.Rating, x),
+	}
+}
+
+func ConvertToCodasMatrix(m *matrix.Matrix) *CodasMatrix {
+	return &CodasMatrix{
+		Matrix:     matrix.CopyMatrix(m),
+		Euclidean:  make([]eval.Number, m.CountAlternatives),
+		Taxicab:    make([]eval.Number, m.CountAlternatives),
+		Assessment: make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func (cm *CodasMatrix) GetCoefs() []eval.Rating {
+	return cm.Assessment
+}
+
+func (cm *CodasMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range cm.Data {
+		s += cm.Data[i].String() + "\n"
+	}
+
+	if cm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < cm.CountCriteria; i++ {
+			s += cm.Criteria[i].String() + " "
+		}
+	}
+
+	if cm.IdealFind {
+		s += "\nNegative-ideal alternative:\n" + cm.NegativeIdeal.String()
+	}
+
+	if cm.DistancesFind {
+		s += "\nEuclidean distances:\n"
+		for i := 0; i < cm.CountAlternatives; i++ {
+			s += cm.Euclidean[i].String() + " "
+		}
+		s += "\nTaxicab distances:\n"
+		for i := 0; i < cm.CountAlternatives; i++ {
+			s += cm.Taxicab[i].String() + " "
+		}
+	}
+
+	if cm.AssessmentFind {
+		s += "\nAssessment scores:\n"
+		for i := 0; i < cm.CountAlternatives; i++ {
+			s += cm.Assessment[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateDistances(matrices []CodasMatrix, weights []eval.Evaluated) (*CodasMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewCodasMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i := 0; i < x; i++ {
+			result.Euclidean[i] += matrices[k].Euclidean[i] * weights[k].ConvertToNumber()
+			result.Taxicab[i] += matrices[k].Taxicab[i] * weights[k].ConvertToNumber()
+		}
+	}
+	result.DistancesFind = true
+	return result, nil
+}
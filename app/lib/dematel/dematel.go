@@ -0,0 +1,287 @@
+package dematel
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+type Influence struct {
+	Data      [][]eval.Number `json:"influence,omitempty"`
+	FuzzyData [][]*eval.T1FS  `json:"fuzzy_influence,omitempty"`
+}
+
+type Result struct {
+	Total      [][]eval.Number `json:"total"`
+	Prominence []eval.Number   `json:"prominence"`
+	Relation   []eval.Number   `json:"relation"`
+	Weights    []eval.Number   `json:"weights"`
+}
+
+func (in *Influence) IsFuzzy() bool {
+	return len(in.FuzzyData) != 0
+}
+
+func (in *Influence) Size() int {
+	if in.IsFuzzy() {
+		return len(in.FuzzyData)
+	}
+	return len(in.Data)
+}
+
+func (in *Influence) Validate() error {
+	size := in.Size()
+	if size < 2 {
+		return v.InvalidSize
+	}
+
+	for i := 0; i < size; i++ {
+		if in.IsFuzzy() {
+			if len(in.FuzzyData[i]) != size {
+				return v.InvalidSize
+			}
+			for j := 0; j < size; j++ {
+				if in.FuzzyData[i][j] == nil || len(in.FuzzyData[i][j].Vert) != len(in.FuzzyData[0][0].Vert) {
+					return v.IncompatibleTypes
+				}
+				for _, vert := range in.FuzzyData[i][j].Vert {
+					if vert < 0 || (i == j && vert != 0) {
+						return v.InvalidCaseOfOperation
+					}
+				}
+			}
+		} else {
+			if len(in.Data[i]) != size {
+				return v.InvalidSize
+			}
+			for j := 0; j < size; j++ {
+				if in.Data[i][j] < 0 || (i == j && in.Data[i][j] != 0) {
+					return v.InvalidCaseOfOperation
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func normalize(data [][]eval.Number, scale eval.Number) [][]eval.Number {
+	result := make([][]eval.Number, len(data))
+	for i := range data {
+		result[i] = make([]eval.Number, len(data[i]))
+		for j := range data[i] {
+			result[i][j] = data[i][j] / scale
+		}
+	}
+	return result
+}
+
+// scaleOf returns the largest row or column sum of influences and the sum
+// of all of them.
+func scaleOf(data [][]eval.Number) (eval.Number, eval.Number) {
+	scale, total := eval.Number(0), eval.Number(0)
+	for i := range data {
+		rows, cols := eval.Number(0), eval.Number(0)
+		for j := range data {
+			rows += data[i][j]
+			cols += data[j][i]
+		}
+		scale = max(scale, rows, cols)
+		total += rows
+	}
+	return scale, total
+}
+
+// totalRelations normalizes every component of influences by the scale of
+// the last one and returns their total relations. When influences within a
+// group of criteria all sum to the scale, as in a matrix with equal row sums,
+// the series of indirect influences diverges and I-X is singular. Then the
+// sum of all influences is taken as the divisor: it keeps every row sum
+// below one unless a single row or column holds all influences, whose
+// matrix is nilpotent, so the series converges for any matrix.
+func totalRelations(components ...[][]eval.Number) ([][][]eval.Number, error) {
+	scale, total := scaleOf(components[len(components)-1])
+	if scale == 0 {
+		return nil, v.EmptyValues
+	}
+
+	result, err := normalizedRelations(components, scale)
+	if errors.Is(err, v.SingularMatrix) {
+		result, err = normalizedRelations(components, total)
+	}
+	return result, err
+}
+
+func normalizedRelations(components [][][]eval.Number, scale eval.Number) ([][][]eval.Number, error) {
+	result := make([][][]eval.Number, len(components))
+	for k := range components {
+		var err error
+		if result[k], err = totalRelation(normalize(components[k], scale)); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func totalRelation(x [][]eval.Number) ([][]eval.Number, error) {
+	n := len(x)
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, 2*n)
+		for j := 0; j < n; j++ {
+			a[i][j] = -float64(x[i][j])
+			if i == j {
+				a[i][j]++
+			}
+		}
+		a[i][n+i] = 1
+	}
+
+	for c := 0; c < n; c++ {
+		pivot := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(a[r][c]) > math.Abs(a[pivot][c]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][c]) < 1e-12 {
+			return nil, v.SingularMatrix
+		}
+		a[c], a[pivot] = a[pivot], a[c]
+
+		p := a[c][c]
+		for j := range a[c] {
+			a[c][j] /= p
+		}
+		for r := 0; r < n; r++ {
+			if r == c || a[r][c] == 0 {
+				continue
+			}
+			f := a[r][c]
+			for j := range a[r] {
+				a[r][j] -= f * a[c][j]
+			}
+		}
+	}
+
+	total := make([][]eval.Number, n)
+	for i := range total {
+		total[i] = make([]eval.Number, n)
+		for j := 0; j < n; j++ {
+			sum := 0.
+			for k := 0; k < n; k++ {
+				sum += float64(x[i][k]) * a[k][n+j]
+			}
+			total[i][j] = eval.Number(sum)
+		}
+	}
+	return total, nil
+}
+
+func (in *Influence) TotalRelation() ([][]eval.Number, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !in.IsFuzzy() {
+		total, err := totalRelations(in.Data)
+		if err != nil {
+			return nil, err
+		}
+		return total[0], nil
+	}
+
+	size := in.Size()
+	count := len(in.FuzzyData[0][0].Vert)
+	components := make([][][]eval.Number, count)
+	for k := range components {
+		components[k] = make([][]eval.Number, size)
+		for i := 0; i < size; i++ {
+			components[k][i] = make([]eval.Number, size)
+			for j := 0; j < size; j++ {
+				components[k][i][j] = in.FuzzyData[i][j].Vert[k]
+			}
+		}
+	}
+
+	components, err := totalRelations(components...)
+	if err != nil {
+		return nil, err
+	}
+
+	total := make([][]eval.Number, size)
+	for i := 0; i < size; i++ {
+		total[i] = make([]eval.Number, size)
+		for j := 0; j < size; j++ {
+			vert := make([]eval.Number, count)
+			for k := range vert {
+				vert[k] = components[k][i][j]
+			}
+			total[i][j] = eval.Defuzzify(eval.NewT1FS(vert...))
+		}
+	}
+	return total, nil
+}
+
+func (in *Influence) Calc() (Result, error) {
+	total, err := in.TotalRelation()
+	if err != nil {
+		return Result{}, err
+	}
+
+	size := len(total)
+	result := Result{
+		Total:      total,
+		Prominence: make([]eval.Number, size),
+		Relation:   make([]eval.Number, size),
+		Weights:    make([]eval.Number, size),
+	}
+
+	sum := eval.Number(0)
+	for i := 0; i < size; i++ {
+		dispatched, received := eval.Number(0), eval.Number(0)
+		for j := 0; j < size; j++ {
+			dispatched += total[i][j]
+			received += total[j][i]
+		}
+		result.Prominence[i] = dispatched + received
+		result.Relation[i] = dispatched - received
+		result.Weights[i] = eval.Number(math.Hypot(float64(result.Prominence[i]), float64(result.Relation[i])))
+		sum += result.Weights[i]
+	}
+
+	if sum == 0 {
+		return Result{}, v.EmptyValues
+	}
+	for i := range result.Weights {
+		result.Weights[i] /= sum
+	}
+	return result, nil
+}
+
+func (in Influence) Value() (driver.Value, error) {
+	data, err := json.Marshal(in)
+	return string(data), err
+}
+
+func (in *Influence) Scan(src interface{}) error {
+	var tmp Influence
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for Influence")
+	}
+	if err != nil {
+		return err
+	}
+	*in = tmp
+	return nil
+}
@@ -0,0 +1,84 @@
+package dematel
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"testing"
+	"webApp/lib/eval"
+)
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		influence  Influence
+		prominence []eval.Number
+		relation   []eval.Number
+		weights    []eval.Number
+	}{
+		{
+			influence:  Influence{Data: [][]eval.Number{{0, 3, 2}, {1, 0, 4}, {2, 1, 0}}},
+			prominence: []eval.Number{4.532, 4.581, 4.823},
+			relation:   []eval.Number{0.823, 0.387, -1.210},
+			weights:    []eval.Number{0.325, 0.324, 0.351},
+		},
+		{
+			influence: Influence{FuzzyData: [][]*eval.T1FS{
+				{eval.NewT1FS(0, 0, 0), eval.NewT1FS(2, 3, 4), eval.NewT1FS(1, 2, 3)},
+				{eval.NewT1FS(0, 1, 2), eval.NewT1FS(0, 0, 0), eval.NewT1FS(3, 4, 4)},
+				{eval.NewT1FS(1, 2, 3), eval.NewT1FS(0, 1, 2), eval.NewT1FS(0, 0, 0)},
+			}},
+			prominence: []eval.Number{3.977, 3.976, 4.134},
+			relation:   []eval.Number{0.646, 0.203, -0.849},
+			weights:    []eval.Number{0.329, 0.325, 0.345},
+		},
+		{
+			influence:  Influence{Data: [][]eval.Number{{0, 3}, {3, 0}}},
+			prominence: []eval.Number{2, 2},
+			relation:   []eval.Number{0, 0},
+			weights:    []eval.Number{0.5, 0.5},
+		},
+		{
+			influence:  Influence{Data: [][]eval.Number{{0, 2, 2}, {2, 0, 2}, {2, 2, 0}}},
+			prominence: []eval.Number{1, 1, 1},
+			relation:   []eval.Number{0, 0, 0},
+			weights:    []eval.Number{0.333, 0.333, 0.333},
+		},
+		{
+			influence: Influence{FuzzyData: [][]*eval.T1FS{
+				{eval.NewT1FS(0, 0, 0), eval.NewT1FS(1, 2, 3)},
+				{eval.NewT1FS(1, 2, 3), eval.NewT1FS(0, 0, 0)},
+			}},
+			prominence: []eval.Number{1.067, 1.067},
+			relation:   []eval.Number{0, 0},
+			weights:    []eval.Number{0.5, 0.5},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := tt.influence.Calc(); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(res.Total, res.Prominence, res.Relation, res.Weights)
+				for j := range res.Weights {
+					if math.Abs(float64(res.Prominence[j]-tt.prominence[j])) > 0.01 {
+						t.Errorf("got prominence %f, want %f\n", res.Prominence[j], tt.prominence[j])
+					}
+					if math.Abs(float64(res.Relation[j]-tt.relation[j])) > 0.01 {
+						t.Errorf("got relation %f, want %f\n", res.Relation[j], tt.relation[j])
+					}
+					if math.Abs(float64(res.Weights[j]-tt.weights[j])) > 0.01 {
+						t.Errorf("got weight %f, want %f\n", res.Weights[j], tt.weights[j])
+					}
+				}
+			}
+		})
+	}
+
+	invalid := Influence{Data: [][]eval.Number{{1, 3}, {1, 0}}}
+	if _, err := invalid.Calc(); err == nil {
+		t.Errorf("expected error for non-zero diagonal")
+	}
+}
@@ -0,0 +1,118 @@
+package edas
+
+import (
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func (em *EdasMatrix) FindAverage() {
+	em.Average = matrix.Alternative{Grade: make([]eval.Rating, em.CountCriteria), CountOfCriteria: em.CountCriteria}
+	for j := 0; j < em.CountCriteria; j++ {
+		sum := em.Data[0].Grade[j].CopyEval()
+		for i := 1; i < em.CountAlternatives; i++ {
+			sum = sum.Sum(em.Data[i].Grade[j])
+		}
+		em.Average.Grade[j] = sum.Weighted(eval.Number(1) / eval.Number(em.CountAlternatives))
+	}
+	em.AverageFind = true
+}
+
+func (em *EdasMatrix) FindDistancesToAverage(vn v.Variants, g int) error {
+	var wg sync.WaitGroup
+	var err error
+
+	average := make([]eval.Number, em.CountCriteria)
+	weights := make([]eval.Number, em.CountCriteria)
+	for j, c := range em.Criteria {
+		average[j] = eval.Crisp(em.Average.Grade[j], em.Defuzzification)
+		if average[j] == 0 {
+			return v.EmptyValues
+		}
+		weights[j] = eval.Crisp(c.Weight, em.Defuzzification)
+	}
+
+	if g > em.CountAlternatives {
+		g = em.CountAlternatives
+	}
+	off := em.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = em.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				em.PositiveDistances[i], em.NegativeDistances[i] = 0, 0
+				for j, c := range em.Criteria {
+					d, inerr := eval.Distance(em.Data[i].Grade[j], em.Average.Grade[j], vn)
+					if inerr != nil {
+						err = inerr
+						return
+					}
+
+					diff := eval.Crisp(em.Data[i].Grade[j], em.Defuzzification) - average[j]
+					if c.TypeOfCriteria == v.Cost {
+						diff = -diff
+					}
+
+					if diff > 0 {
+						em.PositiveDistances[i] += weights[j] * d / average[j]
+					} else if diff < 0 {
+						em.NegativeDistances[i] += weights[j] * d / average[j]
+					}
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if err == nil {
+		em.DistancesFind = true
+	}
+	return err
+}
+
+func (em *EdasMatrix) CalcAppraisalScores() {
+	maxPositive, maxNegative := eval.Number(0), eval.Number(0)
+	for i := 0; i < em.CountAlternatives; i++ {
+		maxPositive = max(maxPositive, em.PositiveDistances[i])
+		maxNegative = max(maxNegative, em.NegativeDistances[i])
+	}
+
+	for i := 0; i < em.CountAlternatives; i++ {
+		positive, negative := eval.Number(0), eval.Number(1)
+		if maxPositive != 0 {
+			positive = em.PositiveDistances[i] / maxPositive
+		}
+		if maxNegative != 0 {
+			negative = 1 - em.NegativeDistances[i]/maxNegative
+		}
+		em.AppraisalScores[i] = eval.Rating{Evaluated: (positive + negative) / 2}
+	}
+	em.ScoresFind = true
+}
+
+func (em *EdasMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(em.AppraisalScores))
+	ind := make([]int, len(em.AppraisalScores))
+	for i := range set {
+		ind[i] = i
+		set[i] = em.AppraisalScores[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], em.Defuzzification) > eval.Crisp(set[ind[j]], em.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], em.Defuzzification) > eval.Crisp(set[j], em.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
@@ -0,0 +1,78 @@
+package edas
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func EdasCalculating(edasMatrix *EdasMatrix, weightNorm, vn v.Variants) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(1, *edasMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	if err := edasMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, err
+	}
+
+	edasMatrix.FindAverage()
+
+	if err := edasMatrix.FindDistancesToAverage(vn, 5); err != nil {
+		return nil, err
+	}
+
+	edasMatrix.CalcAppraisalScores()
+
+	return edasMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *EdasMatrix
+		weightNorm v.Variants
+		vn         v.Variants
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToEdasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			vn:         v.SqrtDistance,
+			resultRow:  []eval.Number{0.856, 0.731, 0.184, 0.145},
+		},
+		{
+			initMat:    ConvertToEdasMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			vn:         v.SqrtDistance,
+			resultRow:  []eval.Number{0.543, 0.652, 0.242},
+		},
+		{
+			initMat:    ConvertToEdasMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			vn:         v.CbrtDistance,
+			resultRow:  []eval.Number{0.195, 0.045, 0.616, 0.660},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := EdasCalculating(tt.initMat, tt.weightNorm, tt.vn); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
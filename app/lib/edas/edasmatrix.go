@@ -0,0 +1,96 @@
+package edas
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type EdasMatrix struct {
+	*matrix.Matrix
+	Average           matrix.Alternative `json:"average"`
+	AverageFind       bool               `json:"is_average_find"`
+	PositiveDistances []eval.Number      `json:"pos_dist"`
+	NegativeDistances []eval.Number      `json:"neg_dist"`
+	DistancesFind     bool               `json:"is_dist_find"`
+	AppraisalScores   []eval.Rating      `json:"appraisal_scores"`
+	ScoresFind        bool               `json:"is_scores_find"`
+}
+
+func NewEdasMatrix(x, y int) *EdasMatrix {
+	return &EdasMatrix{
+		Matrix:            matrix.NewMatrix(x, y),
+		PositiveDistances: make([]eval.Number, x),
+		NegativeDistances: make([]eval.Number, x),
+		AppraisalScores:   make([]eval.Rating, x),
+	}
+}
+
+func ConvertToEdasMatrix(m *matrix.Matrix) *EdasMatrix {
+	return &EdasMatrix{
+		Matrix:            matrix.CopyMatrix(m),
+		PositiveDistances: make([]eval.Number, m.CountAlternatives),
+		NegativeDistances: make([]eval.Number, m.CountAlternatives),
+		AppraisalScores:   make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func (em *EdasMatrix) GetCoefs() []eval.Rating {
+	return em.AppraisalScores
+}
+
+func (em *EdasMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range em.Data {
+		s += em.Data[i].String() + "\n"
+	}
+
+	if em.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < em.CountCriteria; i++ {
+			s += em.Criteria[i].String() + " "
+		}
+	}
+
+	if em.AverageFind {
+		s += "\nAverage solution:\n" + em.Average.String()
+	}
+
+	if em.DistancesFind {
+		s += "\nPositive distances from average:\n"
+		for i := 0; i < em.CountAlternatives; i++ {
+			s += em.PositiveDistances[i].String() + " "
+		}
+		s += "\nNegative distances from average:\n"
+		for i := 0; i < em.CountAlternatives; i++ {
+			s += em.NegativeDistances[i].String() + " "
+		}
+	}
+
+	if em.ScoresFind {
+		s += "\nAppraisal scores:\n"
+		for i := 0; i < em.CountAlternatives; i++ {
+			s += em.AppraisalScores[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateDistances(matrices []EdasMatrix, weights []eval.Evaluated) (*EdasMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewEdasMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i := 0; i < x; i++ {
+			result.PositiveDistances[i] += matrices[k].PositiveDistances[i] * weights[k].ConvertToNumber()
+			result.NegativeDistances[i] += matrices[k].NegativeDistances[i] * weights[k].ConvertToNumber()
+		}
+	}
+	result.DistancesFind = true
+	return result, nil
+}
@@ -0,0 +1,243 @@
+package electre
+
+import (
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const (
+	DistillationAlpha = -0.15
+	DistillationBeta  = 0.3
+)
+
+func discrimination(lambda eval.Number) eval.Number {
+	return DistillationAlpha*lambda + DistillationBeta
+}
+
+func partialConcordance(t matrix.Thresholds, d float64) eval.Number {
+	if d <= t.Indifference {
+		return 1
+	} else if d >= t.Preference {
+		return 0
+	}
+	return eval.Number((t.Preference - d) / (t.Preference - t.Indifference))
+}
+
+func partialDiscordance(t matrix.Thresholds, d float64) eval.Number {
+	if t.Veto <= t.Preference || d <= t.Preference {
+		return 0
+	} else if d >= t.Veto {
+		return 1
+	}
+	return eval.Number((d - t.Preference) / (t.Veto - t.Preference))
+}
+
+func (em *ElectreMatrix) CalcCredibility(g int) {
+	var wg sync.WaitGroup
+	if g > em.CountAlternatives {
+		g = em.CountAlternatives
+	}
+
+	sumWeights := eval.Number(0)
+	for _, c := range em.Criteria {
+		sumWeights += eval.Crisp(c.Weight, em.Defuzzification)
+	}
+
+	off := em.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = em.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				for k := range em.Data {
+					if i == k {
+						em.Concordance[i][k], em.Credibility[i][k] = 1, 1
+						continue
+					}
+
+					concordance := eval.Number(0)
+					discordance := make([]eval.Number, em.CountCriteria)
+					for j, c := range em.Criteria {
+						d := float64(eval.Crisp(em.Data[k].Grade[j], em.Defuzzification) - eval.Crisp(em.Data[i].Grade[j], em.Defuzzification))
+						if c.TypeOfCriteria == v.Cost {
+							d = -d
+						}
+						concordance += eval.Crisp(c.Weight, em.Defuzzification) * partialConcordance(c.Thresholds, d)
+						discordance[j] = partialDiscordance(c.Thresholds, d)
+					}
+					if sumWeights != 0 {
+						concordance /= sumWeights
+					}
+
+					credibility := concordance
+					for j := range discordance {
+						if discordance[j] > concordance {
+							credibility *= (1 - discordance[j]) / (1 - concordance)
+						}
+					}
+
+					em.Concordance[i][k] = concordance
+					em.Credibility[i][k] = credibility
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+	em.CredibilityFind = true
+}
+
+func (em *ElectreMatrix) qualification(set []int, lambda eval.Number) []int {
+	result := make([]int, len(set))
+	for i, a := range set {
+		for _, b := range set {
+			if a == b {
+				continue
+			}
+
+			if em.Credibility[a][b] > lambda &&
+				em.Credibility[a][b]-em.Credibility[b][a] > discrimination(em.Credibility[a][b]) {
+				result[i]++
+			}
+			if em.Credibility[b][a] > lambda &&
+				em.Credibility[b][a]-em.Credibility[a][b] > discrimination(em.Credibility[b][a]) {
+				result[i]--
+			}
+		}
+	}
+	return result
+}
+
+func (em *ElectreMatrix) distillation(descending bool) []int {
+	ranks := make([]int, em.CountAlternatives)
+	remaining := make([]int, em.CountAlternatives)
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	rank := 0
+	for len(remaining) > 0 {
+		set := remaining
+
+		lambda := eval.Number(0)
+		for _, a := range set {
+			for _, b := range set {
+				if a != b && em.Credibility[a][b] > lambda {
+					lambda = em.Credibility[a][b]
+				}
+			}
+		}
+
+		for len(set) > 1 && lambda > 0 {
+			next := eval.Number(0)
+			for _, a := range set {
+				for _, b := range set {
+					if a != b && em.Credibility[a][b] < lambda-discrimination(lambda) && em.Credibility[a][b] > next {
+						next = em.Credibility[a][b]
+					}
+				}
+			}
+
+			qualification := em.qualification(set, next)
+			best := qualification[0]
+			for _, q := range qualification {
+				if (descending && q > best) || (!descending && q < best) {
+					best = q
+				}
+			}
+
+			chosen := make([]int, 0, len(set))
+			for i, q := range qualification {
+				if q == best {
+					chosen = append(chosen, set[i])
+				}
+			}
+			set = chosen
+			lambda = next
+		}
+
+		rank++
+		rest := make([]int, 0, len(remaining))
+		for _, a := range remaining {
+			chosen := false
+			for _, c := range set {
+				if a == c {
+					chosen = true
+					break
+				}
+			}
+
+			if chosen {
+				ranks[a] = rank
+			} else {
+				rest = append(rest, a)
+			}
+		}
+		remaining = rest
+	}
+
+	if !descending {
+		for i := range ranks {
+			ranks[i] = rank + 1 - ranks[i]
+		}
+	}
+	return ranks
+}
+
+func (em *ElectreMatrix) Distillation() {
+	em.DescendingRanks = em.distillation(true)
+	em.AscendingRanks = em.distillation(false)
+	em.DistillFind = true
+}
+
+func (em *ElectreMatrix) PreOrder() matrix.PartialOrder {
+	order := matrix.NewPartialOrder(em.CountAlternatives)
+
+	for a := range em.Data {
+		for b := range em.Data {
+			if a == b {
+				continue
+			}
+
+			descA, descB := em.DescendingRanks[a], em.DescendingRanks[b]
+			ascA, ascB := em.AscendingRanks[a], em.AscendingRanks[b]
+
+			if descA == descB && ascA == ascB {
+				order.Relations[a][b] = v.Indifference
+			} else if descA <= descB && ascA <= ascB {
+				order.Relations[a][b] = v.Preference
+			} else if descA >= descB && ascA >= ascB {
+				order.Relations[a][b] = v.InversePreference
+			} else {
+				order.Relations[a][b] = v.Incomparability
+			}
+		}
+	}
+	return order
+}
+
+func (em *ElectreMatrix) RankedList(_ v.Variants) matrix.RankedList {
+	set := make([]eval.Rating, em.CountAlternatives)
+	ind := make([]int, em.CountAlternatives)
+	for i := range set {
+		ind[i] = i
+		set[i] = eval.Rating{Evaluated: eval.Number(em.DescendingRanks[i]+em.AscendingRanks[i]) / 2}
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], em.Defuzzification) < eval.Crisp(set[ind[j]], em.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], em.Defuzzification) < eval.Crisp(set[j], em.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
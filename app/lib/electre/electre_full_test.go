@@ -0,0 +1,118 @@
+package electre
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func ElectreCalculating(electreMatrix *ElectreMatrix, thresholds matrix.Thresholds, weightNorm v.Variants) ([]int, []int, error) {
+	for j := 0; j < electreMatrix.CountCriteria; j++ {
+		if err := electreMatrix.SetThresholds(thresholds, j); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := electreMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, nil, err
+	}
+
+	electreMatrix.CalcCredibility(5)
+
+	electreMatrix.Distillation()
+
+	return electreMatrix.DescendingRanks, electreMatrix.AscendingRanks, nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *ElectreMatrix
+		thresholds matrix.Thresholds
+		weightNorm v.Variants
+		descending []int
+		ascending  []int
+	}{
+		{
+			initMat:    ConvertToElectreMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			thresholds: matrix.Thresholds{Indifference: 0.5, Preference: 2, Veto: 6},
+			weightNorm: v.NormalizeWithSum,
+			descending: []int{2, 1, 3, 3},
+			ascending:  []int{1, 1, 3, 2},
+		},
+		{
+			initMat:    ConvertToElectreMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			thresholds: matrix.Thresholds{Preference: 1, Veto: 3},
+			weightNorm: v.NormalizeWithSum,
+			descending: []int{2, 1, 3, 3},
+			ascending:  []int{1, 1, 3, 2},
+		},
+		{
+			initMat:    ConvertToElectreMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			thresholds: matrix.Thresholds{Indifference: 0.5, Preference: 2, Veto: 5},
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			descending: []int{1, 2, 2},
+			ascending:  []int{1, 1, 2},
+		},
+		{
+			initMat:    ConvertToElectreMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			thresholds: matrix.Thresholds{Indifference: 0.5, Preference: 2, Veto: 5},
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			descending: []int{3, 3, 1, 2},
+			ascending:  []int{2, 2, 1, 1},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if desc, asc, err := ElectreCalculating(tt.initMat, tt.thresholds, tt.weightNorm); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList(v.Default), tt.initMat.PreOrder())
+				if !reflect.DeepEqual(desc, tt.descending) || !reflect.DeepEqual(asc, tt.ascending) {
+					t.Errorf("got %v %v, want %v %v\n", desc, asc, tt.descending, tt.ascending)
+				}
+			}
+		})
+	}
+}
+
+func TestDefuzzificationStrategy(t *testing.T) {
+	var tests = []struct {
+		method      v.Variants
+		concordance eval.Number
+	}{
+		{method: v.Centroid, concordance: 0.55},
+		{method: v.GradedMeanIntegration, concordance: 0.438},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			m := NewElectreMatrix(2, 2)
+			_ = m.SetValue(eval.Number(1), 0, 0)
+			_ = m.SetValue(eval.Number(0), 0, 1)
+			_ = m.SetValue(eval.Number(0), 1, 0)
+			_ = m.SetValue(eval.Number(1), 1, 1)
+			_ = m.SetCriterion(eval.NewT1FS(0, 1, 10), v.Benefit, 0)
+			_ = m.SetCriterion(eval.NewT1FS(2, 3, 4), v.Benefit, 1)
+			for j := 0; j < m.CountCriteria; j++ {
+				_ = m.SetThresholds(matrix.Thresholds{Indifference: 0, Preference: 0.5}, j)
+			}
+			m.Defuzzification = tt.method
+
+			m.CalcCredibility(1)
+			fmt.Println(m.Concordance)
+			if !m.Concordance[0][1].Equals(tt.concordance) {
+				t.Errorf("got %s, want %s", m.Concordance[0][1].String(), tt.concordance.String())
+			}
+		})
+	}
+}
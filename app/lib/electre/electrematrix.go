@@ -0,0 +1,107 @@
+package electre
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type ElectreMatrix struct {
+	*matrix.Matrix
+	Concordance     [][]eval.Number `json:"concordance"`
+	Credibility     [][]eval.Number `json:"credibility"`
+	CredibilityFind bool            `json:"is_cred_find"`
+	DescendingRanks []int           `json:"desc_ranks"`
+	AscendingRanks  []int           `json:"asc_ranks"`
+	DistillFind     bool            `json:"is_distill_find"`
+}
+
+func NewElectreMatrix(x, y int) *ElectreMatrix {
+	return &ElectreMatrix{
+		Matrix:          matrix.NewMatrix(x, y),
+		Concordance:     newSquare(x),
+		Credibility:     newSquare(x),
+		DescendingRanks: make([]int, x),
+		AscendingRanks:  make([]int, x),
+	}
+}
+
+func ConvertToElectreMatrix(m *matrix.Matrix) *ElectreMatrix {
+	return &ElectreMatrix{
+		Matrix:          matrix.CopyMatrix(m),
+		Concordance:     newSquare(m.CountAlternatives),
+		Credibility:     newSquare(m.CountAlternatives),
+		DescendingRanks: make([]int, m.CountAlternatives),
+		AscendingRanks:  make([]int, m.CountAlternatives),
+	}
+}
+
+func newSquare(size int) [][]eval.Number {
+	square := make([][]eval.Number, size)
+	for i := range square {
+		square[i] = make([]eval.Number, size)
+	}
+	return square
+}
+
+func (em *ElectreMatrix) GetCredibility() [][]eval.Number {
+	return em.Credibility
+}
+
+func (em *ElectreMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range em.Data {
+		s += em.Data[i].String() + "\n"
+	}
+
+	if em.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < em.CountCriteria; i++ {
+			s += em.Criteria[i].String() + " "
+		}
+	}
+
+	if em.CredibilityFind {
+		s += "\nCredibility matrix:\n"
+		for i := range em.Credibility {
+			s += "[ "
+			for j := range em.Credibility[i] {
+				s += em.Credibility[i][j].String() + " "
+			}
+			s += " ]\n"
+		}
+	}
+
+	if em.DistillFind {
+		s += "\nDescending distillation:\n"
+		for i := 0; i < em.CountAlternatives; i++ {
+			s += eval.Number(em.DescendingRanks[i]).String() + " "
+		}
+		s += "\nAscending distillation:\n"
+		for i := 0; i < em.CountAlternatives; i++ {
+			s += eval.Number(em.AscendingRanks[i]).String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateCredibility(matrices []ElectreMatrix, weights []eval.Evaluated) (*ElectreMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewElectreMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for a := range matrices[k].Credibility {
+			for b := range matrices[k].Credibility[a] {
+				result.Concordance[a][b] += matrices[k].Concordance[a][b] * weights[k].ConvertToNumber()
+				result.Credibility[a][b] += matrices[k].Credibility[a][b] * weights[k].ConvertToNumber()
+			}
+		}
+	}
+	result.CredibilityFind = true
+	return result, nil
+}
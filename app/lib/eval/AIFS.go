@@ -64,8 +64,14 @@ func (a *AIFS) ConvertToInterval() Interval {
 	return a.Decompose
 }
 
+func (a *AIFS) ConvertToGrey() Grey {
+	return a.T1FS.ConvertToGrey()
+}
+
 func (a *AIFS) ConvertToT1FS(f v.Variants) *T1FS {
-	if f == a.Form || f == v.Default {
+	if isSampledForm(f) {
+		return a.ConvertToT1FS(v.Default).ConvertToT1FS(f)
+	} else if f == a.Form || f == v.Default {
 		return NewT1FS(a.Vert...)
 	} else if f == v.Triangle && a.Form == v.Trapezoid {
 		return NewT1FS(a.Vert[0], (a.Vert[1]+a.Vert[2])/2, a.Vert[3])
@@ -75,6 +81,7 @@ func (a *AIFS) ConvertToT1FS(f v.Variants) *T1FS {
 }
 
 func (a *AIFS) ConvertToAIFS(f v.Variants) *AIFS {
+	f = classicForm(f)
 	if f == a.Form || f == v.Default {
 		return a
 	} else if f == v.Triangle && a.Form == v.Trapezoid {
@@ -84,7 +91,27 @@ func (a *AIFS) ConvertToAIFS(f v.Variants) *AIFS {
 	}
 }
 
+func (a *AIFS) ConvertToPFS(f v.Variants) *PFS {
+	f = classicForm(f)
+	if f == a.Form || f == v.Default {
+		return NewPFS(1-a.Pi, 0.0, a.Vert...)
+	} else if f == v.Triangle && a.Form == v.Trapezoid {
+		return NewPFS(1-a.Pi, 0.0, a.Vert[0], (a.Vert[1]+a.Vert[2])/2, a.Vert[3])
+	} else {
+		return NewPFS(1-a.Pi, 0.0, a.Vert[0], a.Vert[1], a.Vert[1], a.Vert[2])
+	}
+}
+
+func (a *AIFS) ConvertToHFS(_ v.Variants) *HFS {
+	return Defuzzify(a).ConvertToHFS(v.Default)
+}
+
+func (a *AIFS) ConvertToNS(_ v.Variants) *NS {
+	return Defuzzify(a).ConvertToNS(v.Default)
+}
+
 func (a *AIFS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	f = classicForm(f)
 	delta1 := Number(math.Min(float64(a.Pi*(a.Vert[1]-a.Vert[0])/(2*(1-a.Pi))), float64(a.Vert[0])))
 	delta2 := a.Pi * (a.Vert[len(a.Vert)-1] - a.Vert[len(a.Vert)-2]) / (2 * (1 - a.Pi))
 
@@ -0,0 +1,189 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	v "webApp/lib/variables"
+)
+
+// GreyWhitening is the whitening coefficient used when a grey number is
+// reduced to a crisp value: 0 takes the upper bound, 1 the lower one.
+var GreyWhitening = Number(0.5)
+
+// Grey is an interval grey number, a value known to lie between its bounds
+// with no information about its distribution.
+type Grey struct {
+	Lower Number `json:"lower"`
+	Upper Number `json:"upper"`
+}
+
+func NewGrey(Lower, Upper Number) Grey {
+	if Lower > Upper {
+		Lower, Upper = Upper, Lower
+	}
+	return Grey{Lower: Lower, Upper: Upper}
+}
+
+func (g Grey) Kernel() Number {
+	return (g.Lower + g.Upper) / 2
+}
+
+func (g Grey) Length() Number {
+	return g.Upper - g.Lower
+}
+
+// Whiten returns the equal weight whitenization alpha*lower + (1-alpha)*upper.
+func (g Grey) Whiten(alpha Number) Number {
+	return alpha*g.Lower + (1-alpha)*g.Upper
+}
+
+// WhiteningWeight is the triangular whitening weight function of the grey
+// number, reaching 1 at its kernel and 0 outside the bounds.
+func (g Grey) WhiteningWeight(x Number) Number {
+	if x < g.Lower || x > g.Upper {
+		return 0
+	} else if g.Lower == g.Upper {
+		return 1
+	}
+
+	k := g.Kernel()
+	if x <= k {
+		return (x - g.Lower) / (k - g.Lower)
+	}
+	return (g.Upper - x) / (g.Upper - k)
+}
+
+// PossibilityDegree is the grey possibility degree P{g <= other}.
+func (g Grey) PossibilityDegree(other Grey) Number {
+	l := float64(g.Length() + other.Length())
+	if l == 0 {
+		if g.Lower < other.Lower {
+			return 1
+		} else if g.Lower > other.Lower {
+			return 0
+		}
+		return 0.5
+	}
+
+	return Number(math.Max(0, l-math.Max(0, float64(g.Upper-other.Lower))) / l)
+}
+
+// PossibilityGeq reports whether g is preferred to other by the grey
+// possibility degree; equally possible numbers are ordered by their length.
+func (g Grey) PossibilityGeq(other Grey) bool {
+	p := other.PossibilityDegree(g)
+	if math.Abs(float64(p-0.5)) < 1e-3 {
+		return g.Length() < other.Length()
+	}
+	return p > 0.5
+}
+
+func (g Grey) CopyEval() Rating {
+	return Rating{Grey{Lower: g.Lower, Upper: g.Upper}}
+}
+
+func (g Grey) String() string {
+	return fmt.Sprintf("⊗[%.3f, %.3f]", g.Lower, g.Upper)
+}
+
+func (g Grey) GetType() string {
+	return reflect.TypeOf(g).String()
+}
+
+func (g Grey) ConvertToNumber() Number {
+	return g.Whiten(GreyWhitening)
+}
+
+func (g Grey) ConvertToInterval() Interval {
+	return Interval{g.Lower, g.Upper}
+}
+
+func (g Grey) ConvertToGrey() Grey {
+	return g
+}
+
+func (g Grey) ConvertToT1FS(f v.Variants) *T1FS {
+	return g.ConvertToInterval().ConvertToT1FS(f)
+}
+
+func (g Grey) ConvertToAIFS(f v.Variants) *AIFS {
+	return g.ConvertToInterval().ConvertToAIFS(f)
+}
+
+func (g Grey) ConvertToPFS(f v.Variants) *PFS {
+	return g.ConvertToInterval().ConvertToPFS(f)
+}
+
+func (g Grey) ConvertToHFS(f v.Variants) *HFS {
+	return g.ConvertToInterval().ConvertToHFS(f)
+}
+
+func (g Grey) ConvertToNS(f v.Variants) *NS {
+	return g.ConvertToInterval().ConvertToNS(f)
+}
+
+func (g Grey) ConvertToIT2FS(f v.Variants) *IT2FS {
+	return g.ConvertToInterval().ConvertToIT2FS(f)
+}
+
+func (g Grey) GetForm() v.Variants {
+	return v.None
+}
+
+// Weighted multiplies the grey number by a crisp or grey weight; the bounds
+// of the product are the extreme products of the bounds.
+func (g Grey) Weighted(Weight Evaluated) Rating {
+	w := Weight.ConvertToGrey()
+	products := []float64{float64(g.Lower * w.Lower), float64(g.Lower * w.Upper),
+		float64(g.Upper * w.Lower), float64(g.Upper * w.Upper)}
+
+	s, f := products[0], products[0]
+	for _, p := range products {
+		s, f = math.Min(s, p), math.Max(f, p)
+	}
+	return Rating{Grey{Number(s), Number(f)}}
+}
+
+func (g Grey) DiffNumber(other Evaluated, variants v.Variants) (Number, error) {
+	if other.GetType() != NumbersMin.GetType() && other.GetType() != g.GetType() {
+		return 0, v.IncompatibleTypes
+	}
+
+	o := other.ConvertToGrey()
+	lower, upper := float64(g.Lower-o.Lower), float64(g.Upper-o.Upper)
+	if variants == v.SqrtDistance {
+		return Number(lower*lower + upper*upper), nil
+	} else if variants == v.CbrtDistance {
+		return Number(math.Abs(math.Pow(lower, 3)) + math.Abs(math.Pow(upper, 3))), nil
+	} else if variants == v.HammingDistance {
+		return Number(math.Abs(lower) + math.Abs(upper)), nil
+	} else {
+		return 0, v.InvalidCaseOfOperation
+	}
+}
+
+func (g Grey) DiffInterval(other Interval, typeOfCriterion bool, variants v.Variants) (Interval, error) {
+	i := g.ConvertToInterval()
+
+	if d, err := i.DiffInterval(other, typeOfCriterion, variants); err != nil {
+		return Interval{}, errors.Join(err)
+	} else {
+		return d, nil
+	}
+}
+
+func (g Grey) Sum(other Evaluated) Rating {
+	o := other.ConvertToGrey()
+	return Rating{Grey{g.Lower + o.Lower, g.Upper + o.Upper}}
+}
+
+func (g Grey) Equals(other Evaluated) bool {
+	if other.GetType() != g.GetType() {
+		return false
+	}
+
+	o := other.ConvertToGrey()
+	return g.Lower.Equals(o.Lower) && g.Upper.Equals(o.Upper)
+}
@@ -0,0 +1,242 @@
+package eval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	v "webApp/lib/variables"
+)
+
+// HFS is a hesitant fuzzy element, a set of possible membership degrees
+// kept in ascending order. Optimistic selects how shorter elements are
+// extended before comparison: with their largest value if set, otherwise
+// with the smallest one. Results of operations inherit it from operands.
+type HFS struct {
+	Values     []Number `json:"hesitant"`
+	Optimistic bool     `json:"-"`
+}
+
+func NewHFS(Values ...Number) *HFS {
+	if len(Values) == 0 {
+		return nil
+	}
+
+	result := &HFS{Values: make([]Number, 0, len(Values))}
+	for _, val := range Values {
+		if val < 0 || val > 1 {
+			return nil
+		}
+		result.Values = append(result.Values, val)
+	}
+
+	sort.Slice(result.Values, func(i, j int) bool {
+		return result.Values[i] < result.Values[j]
+	})
+	return result
+}
+
+// Extend returns values of element lengthened to size by repeating its
+// largest (optimistic) or smallest (pessimistic) value.
+func (h *HFS) Extend(size int, optimistic bool) []Number {
+	values := make([]Number, size)
+	copy(values, h.Values)
+	for i := len(h.Values); i < size; i++ {
+		if optimistic {
+			values[i] = h.Values[len(h.Values)-1]
+		} else {
+			values[i] = h.Values[0]
+		}
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+	return values
+}
+
+func (h *HFS) normalizedPair(other *HFS) ([]Number, []Number) {
+	size := max(len(h.Values), len(other.Values))
+	optimistic := h.Optimistic || other.Optimistic
+	return h.Extend(size, optimistic), other.Extend(size, optimistic)
+}
+
+// withHesitance sets the extension rule of element and returns it.
+func (h *HFS) withHesitance(optimistic bool) *HFS {
+	if h != nil {
+		h.Optimistic = optimistic
+	}
+	return h
+}
+
+func (h *HFS) Score() Number {
+	s := Number(0)
+	for _, val := range h.Values {
+		s += val
+	}
+	return s / Number(len(h.Values))
+}
+
+func (h *HFS) Hamming(other *HFS) Number {
+	a, b := h.normalizedPair(other)
+	d := 0.0
+	for i := range a {
+		d += math.Abs(float64(a[i] - b[i]))
+	}
+	return Number(d / float64(len(a)))
+}
+
+func (h *HFS) Euclidean(other *HFS) Number {
+	a, b := h.normalizedPair(other)
+	d := 0.0
+	for i := range a {
+		d += float64((a[i] - b[i]) * (a[i] - b[i]))
+	}
+	return Number(math.Sqrt(d / float64(len(a))))
+}
+
+func (h *HFS) UnmarshalJSON(data []byte) error {
+	var raw = struct {
+		Values []Number `json:"hesitant"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	hfs := NewHFS(raw.Values...)
+	if hfs == nil {
+		return v.InvalidMembership
+	}
+	*h = *hfs
+	return nil
+}
+
+func (h *HFS) CopyEval() Rating {
+	return Rating{NewHFS(h.Values...).withHesitance(h.Optimistic)}
+}
+
+func (h *HFS) GetType() string {
+	return reflect.TypeOf(h).String()
+}
+
+func (h *HFS) ConvertToNumber() Number {
+	return h.Score()
+}
+
+func (h *HFS) ConvertToInterval() Interval {
+	return Interval{h.Values[0], h.Values[len(h.Values)-1]}
+}
+
+func (h *HFS) ConvertToGrey() Grey {
+	return h.ConvertToInterval().ConvertToGrey()
+}
+
+func (h *HFS) ConvertToT1FS(f v.Variants) *T1FS {
+	i := h.ConvertToInterval()
+	if f == v.Default || f == v.Triangle {
+		return NewT1FS(i.Start, h.Score(), i.End)
+	} else {
+		return i.ConvertToT1FS(f)
+	}
+}
+
+func (h *HFS) ConvertToAIFS(f v.Variants) *AIFS {
+	return h.ConvertToT1FS(f).ConvertToAIFS(v.Default)
+}
+
+func (h *HFS) ConvertToPFS(f v.Variants) *PFS {
+	return h.ConvertToT1FS(f).ConvertToPFS(v.Default)
+}
+
+func (h *HFS) ConvertToHFS(_ v.Variants) *HFS {
+	return h
+}
+
+func (h *HFS) ConvertToNS(f v.Variants) *NS {
+	return h.ConvertToInterval().ConvertToNS(f)
+}
+
+func (h *HFS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	return h.ConvertToT1FS(f).ConvertToIT2FS(v.Default)
+}
+
+func (h *HFS) GetForm() v.Variants {
+	return v.None
+}
+
+// Weighted is the scalar multiplication of hesitant elements, so weighting
+// followed by Sum gives the hesitant fuzzy weighted average.
+func (h *HFS) Weighted(Weight Evaluated) Rating {
+	lambda := float64(Defuzzify(Weight))
+	values := make([]Number, len(h.Values))
+	for i, val := range h.Values {
+		values[i] = unitDegree(Number(1 - math.Pow(1-float64(val), lambda)))
+	}
+	return Rating{NewHFS(values...).withHesitance(h.Optimistic)}
+}
+
+func (h *HFS) String() string {
+	return fmt.Sprint(h.Values)
+}
+
+func (h *HFS) DiffNumber(other Evaluated, variants v.Variants) (Number, error) {
+	if other.GetType() == NumbersMin.GetType() {
+		i := h.ConvertToInterval()
+		return i.DiffNumber(other, variants)
+	} else if other.GetType() == h.GetType() {
+		o := other.ConvertToHFS(v.Default)
+		if variants == v.SqrtDistance {
+			return h.Euclidean(o) * h.Euclidean(o), nil
+		} else if variants == v.CbrtDistance {
+			a, b := h.normalizedPair(o)
+			d := 0.0
+			for i := range a {
+				d += math.Abs(math.Pow(float64(a[i]-b[i]), 3))
+			}
+			return Number(d / float64(len(a))), nil
+		} else if variants == v.HammingDistance {
+			return h.Hamming(o), nil
+		} else {
+			return 0, v.InvalidCaseOfOperation
+		}
+	} else {
+		return 0, v.IncompatibleTypes
+	}
+}
+
+func (h *HFS) DiffInterval(other Interval, typeOfCriterion bool, variants v.Variants) (Interval, error) {
+	i := h.ConvertToInterval()
+
+	if d, err := i.DiffInterval(other, typeOfCriterion, variants); err != nil {
+		return Interval{}, errors.Join(err)
+	} else {
+		return d, nil
+	}
+}
+
+// Sum combines values of the same rank after extending both elements to
+// equal length, which keeps the size of the result bounded.
+func (h *HFS) Sum(other Evaluated) Rating {
+	o := other.ConvertToHFS(v.Default)
+	a, b := h.normalizedPair(o)
+	values := make([]Number, len(a))
+	for i := range values {
+		values[i] = unitDegree(a[i] + b[i] - a[i]*b[i])
+	}
+	return Rating{NewHFS(values...).withHesitance(h.Optimistic || o.Optimistic)}
+}
+
+func (h *HFS) Equals(other Evaluated) bool {
+	if other.GetType() != h.GetType() || len(other.ConvertToHFS(v.Default).Values) != len(h.Values) {
+		return false
+	}
+
+	for i, val := range other.ConvertToHFS(v.Default).Values {
+		if h.Values[i].Equals(val) == false {
+			return false
+		}
+	}
+	return true
+}
@@ -84,17 +84,42 @@ func (t *IT2FS) ConvertToInterval() Interval {
 	return t.Decompose
 }
 
-func (t *IT2FS) ConvertToT1FS(_ v.Variants) *T1FS {
-	fmt.Println("Call deprecated method: ConvertToT1FS Form IT2FS")
-	return nil
+func (t *IT2FS) ConvertToGrey() Grey {
+	return Grey{t.Bottom[0].Start, t.Bottom[1].End}
 }
 
-func (t *IT2FS) ConvertToAIFS(_ v.Variants) *AIFS {
-	fmt.Println("Call deprecated method: ConvertToAIFS Form IT2FS")
-	return nil
+// ConvertToT1FS returns the principal embedded set, whose feet lie halfway
+// between those of upper and lower membership functions.
+func (t *IT2FS) ConvertToT1FS(f v.Variants) *T1FS {
+	vert := append([]Number{(t.Bottom[0].Start + t.Bottom[0].End) / 2}, t.Upward...)
+	principal := NewT1FS(append(vert, (t.Bottom[1].Start+t.Bottom[1].End)/2)...)
+	return principal.ConvertToT1FS(f)
+}
+
+// ConvertToAIFS keeps the footprint of uncertainty as hesitancy: the share
+// of the area under the upper membership function lying above the lower one.
+func (t *IT2FS) ConvertToAIFS(f v.Variants) *AIFS {
+	pi := Number(0)
+	if upper := t.Upper().area(); upper > 0 {
+		pi = (upper - t.Lower().area()) / upper
+	}
+	return NewAIFS(pi, t.ConvertToT1FS(classicForm(f)).Vert...)
+}
+
+func (t *IT2FS) ConvertToPFS(f v.Variants) *PFS {
+	return t.ConvertToAIFS(v.Default).ConvertToPFS(f)
+}
+
+func (t *IT2FS) ConvertToHFS(_ v.Variants) *HFS {
+	return Defuzzify(t).ConvertToHFS(v.Default)
+}
+
+func (t *IT2FS) ConvertToNS(_ v.Variants) *NS {
+	return Defuzzify(t).ConvertToNS(v.Default)
 }
 
 func (t *IT2FS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	f = classicForm(f)
 	if (f == v.Triangle && t.Form == v.Triangle) || (f == v.Trapezoid && t.Form == v.Trapezoid) || f == v.Default {
 		return t
 	} else if f == v.Triangle && t.Form == v.Trapezoid {
@@ -158,6 +183,18 @@ func (t *IT2FS) DiffNumber(other Evaluated, variants v.Variants) (Number, error)
 		}
 
 		return d / Number(len(t.Upward)+4), nil
+	} else if other.GetType() == t.GetType() {
+		o := other.ConvertToIT2FS(t.Form)
+		upper, err := t.Upper().DiffNumber(o.Upper(), variants)
+		if err != nil {
+			return 0, err
+		}
+
+		lower, err := t.Lower().DiffNumber(o.Lower(), variants)
+		if err != nil {
+			return 0, err
+		}
+		return (upper + lower) / 2, nil
 	} else {
 		return 0, v.IncompatibleTypes
 	}
@@ -32,9 +32,15 @@ func (i Interval) ConvertToInterval() Interval {
 	return i
 }
 
+func (i Interval) ConvertToGrey() Grey {
+	return Grey{i.Start, i.End}
+}
+
 func (i Interval) ConvertToT1FS(f v.Variants) *T1FS {
 	if f == v.Default || f == v.Triangle {
 		return NewT1FS(i.Start, i.ConvertToNumber(), i.End)
+	} else if isSampledForm(f) {
+		return NewT1FS(i.Start, i.Start, i.End, i.End).ConvertToT1FS(f)
 	} else {
 		return NewT1FS(i.Start, i.Start, i.End, i.End)
 	}
@@ -48,6 +54,23 @@ func (i Interval) ConvertToAIFS(f v.Variants) *AIFS {
 	}
 }
 
+func (i Interval) ConvertToPFS(f v.Variants) *PFS {
+	if f == v.Default || f == v.Triangle {
+		return NewPFS(1.0, 0.0, i.Start, i.ConvertToNumber(), i.End)
+	} else {
+		return NewPFS(1.0, 0.0, i.Start, i.Start, i.End, i.End)
+	}
+}
+
+func (i Interval) ConvertToHFS(_ v.Variants) *HFS {
+	return NewHFS(unitDegree(i.Start), unitDegree(i.End))
+}
+
+func (i Interval) ConvertToNS(_ v.Variants) *NS {
+	s, f := unitDegree(i.Start), unitDegree(i.End)
+	return NewIntervalNS(Interval{s, f}, Interval{0, 0}, Interval{1 - f, 1 - s})
+}
+
 func (i Interval) ConvertToIT2FS(f v.Variants) *IT2FS {
 	if f == v.Default || f == v.Triangle {
 		return NewIT2FS([]Interval{{i.Start, i.Start}, {i.End, i.End}}, []Number{i.ConvertToNumber()})
@@ -0,0 +1,242 @@
+package eval
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	v "webApp/lib/variables"
+)
+
+// NS is a neutrosophic set with independent degrees of truth, indeterminacy
+// and falsity. Single-valued sets keep degenerate intervals.
+type NS struct {
+	Truth         Interval `json:"truth"`
+	Indeterminacy Interval `json:"indeterminacy"`
+	Falsity       Interval `json:"falsity"`
+}
+
+func NewNS(Truth, Indeterminacy, Falsity Number) *NS {
+	return NewIntervalNS(Truth.ConvertToInterval(), Indeterminacy.ConvertToInterval(), Falsity.ConvertToInterval())
+}
+
+func NewIntervalNS(Truth, Indeterminacy, Falsity Interval) *NS {
+	for _, d := range []Interval{Truth, Indeterminacy, Falsity} {
+		if d.Start < 0 || d.End > 1 || d.Start > d.End {
+			return nil
+		}
+	}
+
+	return &NS{
+		Truth:         Truth,
+		Indeterminacy: Indeterminacy,
+		Falsity:       Falsity,
+	}
+}
+
+func unitDegree(n Number) Number {
+	return Number(math.Min(math.Max(float64(n), 0), 1))
+}
+
+func (n *NS) IsSingleValued() bool {
+	return n.Truth.Start == n.Truth.End && n.Indeterminacy.Start == n.Indeterminacy.End &&
+		n.Falsity.Start == n.Falsity.End
+}
+
+func (n *NS) Score() Number {
+	return (2 + n.Truth.ConvertToNumber() - n.Indeterminacy.ConvertToNumber() - n.Falsity.ConvertToNumber()) / 3
+}
+
+func (n *NS) Accuracy() Number {
+	return n.Truth.ConvertToNumber() - n.Falsity.ConvertToNumber()
+}
+
+func (n *NS) degreesDiff(other *NS) []float64 {
+	return []float64{
+		float64(n.Truth.Start - other.Truth.Start), float64(n.Truth.End - other.Truth.End),
+		float64(n.Indeterminacy.Start - other.Indeterminacy.Start), float64(n.Indeterminacy.End - other.Indeterminacy.End),
+		float64(n.Falsity.Start - other.Falsity.Start), float64(n.Falsity.End - other.Falsity.End),
+	}
+}
+
+func (n *NS) Hamming(other *NS) Number {
+	d := 0.0
+	for _, diff := range n.degreesDiff(other) {
+		d += math.Abs(diff)
+	}
+	return Number(d / 6)
+}
+
+func (n *NS) Euclidean(other *NS) Number {
+	d := 0.0
+	for _, diff := range n.degreesDiff(other) {
+		d += diff * diff
+	}
+	return Number(math.Sqrt(d / 6))
+}
+
+func (n *NS) MarshalJSON() ([]byte, error) {
+	if n.IsSingleValued() {
+		return json.Marshal(struct {
+			Truth         Number `json:"truth"`
+			Indeterminacy Number `json:"indeterminacy"`
+			Falsity       Number `json:"falsity"`
+		}{n.Truth.Start, n.Indeterminacy.Start, n.Falsity.Start})
+	}
+
+	return json.Marshal(struct {
+		Truth         Interval `json:"truth"`
+		Indeterminacy Interval `json:"indeterminacy"`
+		Falsity       Interval `json:"falsity"`
+	}{n.Truth, n.Indeterminacy, n.Falsity})
+}
+
+func (n *NS) UnmarshalJSON(data []byte) error {
+	var raw = struct {
+		Truth         Rating `json:"truth"`
+		Indeterminacy Rating `json:"indeterminacy"`
+		Falsity       Rating `json:"falsity"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.Truth.IsNil() || raw.Indeterminacy.IsNil() || raw.Falsity.IsNil() {
+		return v.InvalidMembership
+	}
+
+	ns := NewIntervalNS(raw.Truth.ConvertToInterval(), raw.Indeterminacy.ConvertToInterval(),
+		raw.Falsity.ConvertToInterval())
+	if ns == nil {
+		return v.InvalidMembership
+	}
+	*n = *ns
+	return nil
+}
+
+func (n *NS) CopyEval() Rating {
+	return Rating{NewIntervalNS(n.Truth, n.Indeterminacy, n.Falsity)}
+}
+
+func (n *NS) GetType() string {
+	return reflect.TypeOf(n).String()
+}
+
+func (n *NS) ConvertToNumber() Number {
+	return n.Score()
+}
+
+func (n *NS) ConvertToInterval() Interval {
+	return Interval{(2 + n.Truth.Start - n.Indeterminacy.End - n.Falsity.End) / 3,
+		(2 + n.Truth.End - n.Indeterminacy.Start - n.Falsity.Start) / 3}
+}
+
+func (n *NS) ConvertToGrey() Grey {
+	return n.ConvertToInterval().ConvertToGrey()
+}
+
+func (n *NS) ConvertToT1FS(f v.Variants) *T1FS {
+	return n.ConvertToInterval().ConvertToT1FS(f)
+}
+
+func (n *NS) ConvertToAIFS(f v.Variants) *AIFS {
+	return n.ConvertToInterval().ConvertToAIFS(f)
+}
+
+func (n *NS) ConvertToPFS(f v.Variants) *PFS {
+	return n.ConvertToInterval().ConvertToPFS(f)
+}
+
+func (n *NS) ConvertToHFS(_ v.Variants) *HFS {
+	return Defuzzify(n).ConvertToHFS(v.Default)
+}
+
+func (n *NS) ConvertToNS(_ v.Variants) *NS {
+	return n
+}
+
+func (n *NS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	return n.ConvertToInterval().ConvertToIT2FS(f)
+}
+
+func (n *NS) GetForm() v.Variants {
+	return v.None
+}
+
+// Weighted is the scalar multiplication of neutrosophic sets, so weighting
+// followed by Sum gives the neutrosophic weighted average.
+func (n *NS) Weighted(Weight Evaluated) Rating {
+	lambda := float64(Defuzzify(Weight))
+	truth := func(d Number) Number {
+		return Number(1 - math.Pow(1-float64(d), lambda))
+	}
+	degree := func(d Number) Number {
+		return Number(math.Pow(float64(d), lambda))
+	}
+
+	return Rating{NewIntervalNS(Interval{truth(n.Truth.Start), truth(n.Truth.End)},
+		Interval{degree(n.Indeterminacy.Start), degree(n.Indeterminacy.End)},
+		Interval{degree(n.Falsity.Start), degree(n.Falsity.End)})}
+}
+
+func (n *NS) String() string {
+	if n.IsSingleValued() {
+		return fmt.Sprintf("<%.3f, %.3f, %.3f>", n.Truth.Start, n.Indeterminacy.Start, n.Falsity.Start)
+	}
+	return "<" + n.Truth.String() + ", " + n.Indeterminacy.String() + ", " + n.Falsity.String() + ">"
+}
+
+func (n *NS) DiffNumber(other Evaluated, variants v.Variants) (Number, error) {
+	if other.GetType() == NumbersMin.GetType() {
+		i := n.ConvertToInterval()
+		return i.DiffNumber(other, variants)
+	} else if other.GetType() == n.GetType() {
+		o := other.ConvertToNS(v.Default)
+		if variants == v.SqrtDistance {
+			return n.Euclidean(o) * n.Euclidean(o), nil
+		} else if variants == v.CbrtDistance {
+			d := 0.0
+			for _, diff := range n.degreesDiff(o) {
+				d += math.Abs(diff * diff * diff)
+			}
+			return Number(d / 6), nil
+		} else if variants == v.HammingDistance {
+			return n.Hamming(o), nil
+		} else {
+			return 0, v.InvalidCaseOfOperation
+		}
+	} else {
+		return 0, v.IncompatibleTypes
+	}
+}
+
+func (n *NS) DiffInterval(other Interval, typeOfCriterion bool, variants v.Variants) (Interval, error) {
+	i := n.ConvertToInterval()
+
+	if d, err := i.DiffInterval(other, typeOfCriterion, variants); err != nil {
+		return Interval{}, errors.Join(err)
+	} else {
+		return d, nil
+	}
+}
+
+func (n *NS) Sum(other Evaluated) Rating {
+	o := other.ConvertToNS(v.Default)
+	truth := func(a, b Number) Number {
+		return a + b - a*b
+	}
+
+	return Rating{NewIntervalNS(Interval{truth(n.Truth.Start, o.Truth.Start), truth(n.Truth.End, o.Truth.End)},
+		Interval{n.Indeterminacy.Start * o.Indeterminacy.Start, n.Indeterminacy.End * o.Indeterminacy.End},
+		Interval{n.Falsity.Start * o.Falsity.Start, n.Falsity.End * o.Falsity.End})}
+}
+
+func (n *NS) Equals(other Evaluated) bool {
+	if other.GetType() != n.GetType() {
+		return false
+	}
+
+	o := other.ConvertToNS(v.Default)
+	return n.Truth.Equals(o.Truth) && n.Indeterminacy.Equals(o.Indeterminacy) && n.Falsity.Equals(o.Falsity)
+}
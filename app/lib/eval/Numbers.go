@@ -33,9 +33,15 @@ func (n Number) ConvertToInterval() Interval {
 	return Interval{n, n}
 }
 
+func (n Number) ConvertToGrey() Grey {
+	return Grey{n, n}
+}
+
 func (n Number) ConvertToT1FS(f v.Variants) *T1FS {
 	if f == v.Default || f == v.Triangle {
 		return NewT1FS(n, n, n)
+	} else if isSampledForm(f) {
+		return NewT1FS(n, n, n, n).ConvertToT1FS(f)
 	} else {
 		return NewT1FS(n, n, n, n)
 	}
@@ -49,6 +55,24 @@ func (n Number) ConvertToAIFS(f v.Variants) *AIFS {
 	}
 }
 
+func (n Number) ConvertToPFS(f v.Variants) *PFS {
+	if f == v.Default || f == v.Triangle {
+		return NewPFS(1.0, 0.0, n, n, n)
+	} else {
+		return NewPFS(1.0, 0.0, n, n, n, n)
+	}
+}
+
+// ConvertToHFS takes the number as a membership degree.
+func (n Number) ConvertToHFS(_ v.Variants) *HFS {
+	return NewHFS(unitDegree(n))
+}
+
+// ConvertToNS takes the number as a degree of truth on the unit scale.
+func (n Number) ConvertToNS(_ v.Variants) *NS {
+	return NewNS(unitDegree(n), 0.0, 1-unitDegree(n))
+}
+
 func (n Number) ConvertToIT2FS(f v.Variants) *IT2FS {
 	if f == v.Default || f == v.Triangle {
 		return NewIT2FS([]Interval{{n, n}, {n, n}}, []Number{n})
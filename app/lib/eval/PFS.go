@@ -0,0 +1,226 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	v "webApp/lib/variables"
+)
+
+type PFS struct {
+	*T1FS
+	Mu Number `json:"mu"`
+	Nu Number `json:"nu"`
+}
+
+func NewPFS(Mu, Nu Number, Vert ...Number) *PFS {
+	if len(Vert) != 3 && len(Vert) != 4 {
+		return nil
+	}
+
+	if Mu < 0 || Nu < 0 || Mu*Mu+Nu*Nu > 1+1e-9 {
+		return nil
+	}
+
+	result := &PFS{
+		T1FS: NewT1FS(Vert...),
+		Mu:   Mu,
+		Nu:   Nu,
+	}
+
+	return result
+}
+
+func (p *PFS) Hesitancy() Number {
+	return Number(math.Sqrt(math.Max(float64(1-p.Mu*p.Mu-p.Nu*p.Nu), 0)))
+}
+
+func (p *PFS) CopyEval() Rating {
+	return Rating{NewPFS(p.Mu, p.Nu, p.Vert...)}
+}
+
+func (p *PFS) MemberFunction(alpha Number) Interval {
+	if alpha > p.Mu || p.Mu == 0 {
+		return Interval{0, 0}
+	}
+
+	if len(p.Vert) == 3 {
+		return Interval{p.Vert[0] + (p.Vert[1]-p.Vert[0])*alpha/p.Mu,
+			p.Vert[2] - (p.Vert[2]-p.Vert[1])*alpha/p.Mu}
+	} else {
+		return Interval{p.Vert[0] + (p.Vert[1]-p.Vert[0])*alpha/p.Mu,
+			p.Vert[3] - (p.Vert[3]-p.Vert[2])*alpha/p.Mu}
+	}
+}
+
+func (p *PFS) GetType() string {
+	return reflect.TypeOf(p).String()
+}
+
+func (p *PFS) ConvertToNumber() Number {
+	i := p.ConvertToInterval()
+	return (i.Start + i.End) / 2
+}
+
+func (p *PFS) ConvertToInterval() Interval {
+	if p.Decompose.Start == NumbersMin && p.Decompose.End == NumbersMin {
+		tmp := Interval{0, 0}
+		if p.Mu > 0 {
+			for alpha := Number(0.0); alpha <= p.Mu; alpha += p.Mu / Number(CountOfAlfaSlices) {
+				tmp = tmp.Sum(p.MemberFunction(alpha).Weighted(Rating{alpha})).ConvertToInterval()
+			}
+		}
+		p.Decompose = tmp
+	}
+	return p.Decompose
+}
+
+func (p *PFS) ConvertToGrey() Grey {
+	return p.T1FS.ConvertToGrey()
+}
+
+func (p *PFS) ConvertToT1FS(f v.Variants) *T1FS {
+	if isSampledForm(f) {
+		return p.ConvertToT1FS(v.Default).ConvertToT1FS(f)
+	} else if f == p.Form || f == v.Default {
+		return NewT1FS(p.Vert...)
+	} else if f == v.Triangle && p.Form == v.Trapezoid {
+		return NewT1FS(p.Vert[0], (p.Vert[1]+p.Vert[2])/2, p.Vert[3])
+	} else {
+		return NewT1FS(p.Vert[0], p.Vert[1], p.Vert[1], p.Vert[2])
+	}
+}
+
+func (p *PFS) ConvertToAIFS(f v.Variants) *AIFS {
+	f = classicForm(f)
+	if f == p.Form || f == v.Default {
+		return NewAIFS(1-p.Mu, p.Vert...)
+	} else if f == v.Triangle && p.Form == v.Trapezoid {
+		return NewAIFS(1-p.Mu, p.Vert[0], (p.Vert[1]+p.Vert[2])/2, p.Vert[3])
+	} else {
+		return NewAIFS(1-p.Mu, p.Vert[0], p.Vert[1], p.Vert[1], p.Vert[2])
+	}
+}
+
+func (p *PFS) ConvertToPFS(f v.Variants) *PFS {
+	f = classicForm(f)
+	if f == p.Form || f == v.Default {
+		return p
+	} else if f == v.Triangle && p.Form == v.Trapezoid {
+		return NewPFS(p.Mu, p.Nu, p.Vert[0], (p.Vert[1]+p.Vert[2])/2, p.Vert[3])
+	} else {
+		return NewPFS(p.Mu, p.Nu, p.Vert[0], p.Vert[1], p.Vert[1], p.Vert[2])
+	}
+}
+
+func (p *PFS) ConvertToHFS(_ v.Variants) *HFS {
+	return Defuzzify(p).ConvertToHFS(v.Default)
+}
+
+func (p *PFS) ConvertToNS(_ v.Variants) *NS {
+	return Defuzzify(p).ConvertToNS(v.Default)
+}
+
+func (p *PFS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	return p.ConvertToAIFS(v.Default).ConvertToIT2FS(f)
+}
+
+func (p *PFS) GetForm() v.Variants {
+	return p.Form
+}
+
+func (p *PFS) Weighted(Weight Evaluated) Rating {
+	wt := NewPFS(p.Mu, p.Nu, p.Vert...)
+	for i := range p.Vert {
+		wt.Vert[i] = p.Vert[i].Weighted(Weight).ConvertToNumber()
+	}
+
+	return Rating{wt}
+}
+
+func (p *PFS) String() string {
+	s := "["
+	for _, num := range p.Vert {
+		s += fmt.Sprint(num) + " "
+	}
+	s += "Mu=" + fmt.Sprint(p.Mu) + " Nu=" + fmt.Sprint(p.Nu) + "]"
+	return s
+}
+
+// degreesScale is the share of a unit of the rating scale given to the
+// distance of degrees, which lies in [0, 1] while vertices differ by units
+// of the scale. The degrees only separate ratings of alike shapes, so the
+// squared and cubed terms of DiffNumber weigh them as 0.1² and 0.1³.
+const degreesScale = 0.1
+
+// degreesDistance is the distance of Zhang and Xu (2014) between
+// the membership, non-membership and hesitancy degrees of two Pythagorean
+// fuzzy sets: ½(|μ₁²−μ₂²| + |ν₁²−ν₂²| + |π₁²−π₂²|).
+func (p *PFS) degreesDistance(other *PFS) Number {
+	mu := math.Abs(float64(p.Mu*p.Mu - other.Mu*other.Mu))
+	nu := math.Abs(float64(p.Nu*p.Nu - other.Nu*other.Nu))
+	pi := math.Abs(float64(p.Hesitancy()*p.Hesitancy() - other.Hesitancy()*other.Hesitancy()))
+	return Number((mu + nu + pi) / 2)
+}
+
+func (p *PFS) DiffNumber(other Evaluated, variants v.Variants) (Number, error) {
+	if other.GetType() == NumbersMin.GetType() {
+		i := p.ConvertToInterval()
+		return i.DiffNumber(other, variants)
+	} else if other.GetType() == p.GetType() {
+		d := Number(0)
+		o := other.ConvertToPFS(v.Default)
+		if variants == v.SqrtDistance {
+			for i := range p.Vert {
+				d += Number(math.Pow(float64(p.Vert[i]-o.Vert[i]), 2))
+			}
+			d += Number(math.Pow(degreesScale*float64(p.degreesDistance(o)), 2))
+		} else if variants == v.CbrtDistance {
+			for i := range p.Vert {
+				d += Number(math.Abs(math.Pow(float64(p.Vert[i]-o.Vert[i]), 3)))
+			}
+			d += Number(math.Pow(degreesScale*float64(p.degreesDistance(o)), 3))
+		} else {
+			return 0, v.InvalidCaseOfOperation
+		}
+		return d / (Number(len(p.Vert)) + 1), nil
+	} else {
+		return 0, v.IncompatibleTypes
+	}
+}
+
+func (p *PFS) DiffInterval(other Interval, typeOfCriterion bool, variants v.Variants) (Interval, error) {
+	i := p.ConvertToInterval()
+
+	if d, err := i.DiffInterval(other, typeOfCriterion, variants); err != nil {
+		return Interval{}, errors.Join(err)
+	} else {
+		return d, nil
+	}
+}
+
+// Sum adds vertices; the degrees of the sum are the weakest of both
+// summands as given by the extension principle.
+func (p *PFS) Sum(other Evaluated) Rating {
+	o := other.ConvertToPFS(v.Default)
+	ret := NewPFS(p.Mu, p.Nu, p.Vert...)
+	for i := range p.Vert {
+		ret.Vert[i] = p.Vert[i] + o.Vert[i]
+	}
+	ret.Mu = Number(math.Min(float64(p.Mu), float64(o.Mu)))
+	ret.Nu = Number(math.Max(float64(p.Nu), float64(o.Nu)))
+	return Rating{ret}
+}
+
+func (p *PFS) Equals(other Evaluated) bool {
+	if other.GetType() != p.GetType() || other.GetForm() != p.GetForm() {
+		return false
+	}
+
+	o := other.ConvertToPFS(v.Default)
+	if p.T1FS.Equals(o.T1FS) == false || p.Mu.Equals(o.Mu) == false || p.Nu.Equals(o.Nu) == false {
+		return false
+	}
+	return true
+}
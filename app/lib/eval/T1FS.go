@@ -10,14 +10,26 @@ import (
 
 var CountOfAlfaSlices = 100
 
+// MembershipCutoff is the membership degree at which Gaussian and bell-shaped
+// sets, whose support is unbounded, are truncated.
+var MembershipCutoff = Number(0.01)
+
+// T1FS is a type-1 fuzzy number. Triangles and trapezoids keep their corner
+// vertices; other forms keep bounds of alpha-cuts at evenly spaced levels up
+// to Height, lower bounds ascending and then upper bounds descending, so a
+// trapezoid is the case with a single level above zero.
 type T1FS struct {
 	Decompose Interval   `json:"decompose"`
 	Vert      []Number   `json:"vert"`
 	Form      v.Variants `json:"form"`
+	Height    Number     `json:"height,omitempty"`
 }
 
+// NewT1FS builds a triangle or a trapezoid from 3 or 4 vertices; an even
+// number of vertices above 4 is taken as a piecewise-linear set of unit height
+// given by its alpha-cuts.
 func NewT1FS(Vert ...Number) *T1FS {
-	if len(Vert) != 3 && len(Vert) != 4 {
+	if len(Vert) != 3 && len(Vert) != 4 && (len(Vert) < 6 || len(Vert)%2 != 0) {
 		return nil
 	}
 
@@ -28,8 +40,10 @@ func NewT1FS(Vert ...Number) *T1FS {
 
 	if len(Vert) == 3 {
 		result.Form = v.Triangle
-	} else {
+	} else if len(Vert) == 4 {
 		result.Form = v.Trapezoid
+	} else {
+		result.Form = v.PiecewiseLinear
 	}
 
 	for i := range Vert {
@@ -39,16 +53,203 @@ func NewT1FS(Vert ...Number) *T1FS {
 	return result
 }
 
+// NewPiecewiseT1FS builds a fuzzy number from ascending vertices and their
+// membership degrees, which must rise to the height of the set and then fall.
+func NewPiecewiseT1FS(Vert, Degrees []Number) *T1FS {
+	if len(Vert) < 2 || len(Vert) != len(Degrees) {
+		return nil
+	}
+
+	top := 0
+	for i := range Vert {
+		if Degrees[i] < 0 || Degrees[i] > 1 || (i > 0 && Vert[i] < Vert[i-1]) {
+			return nil
+		}
+		if Degrees[i] > Degrees[top] {
+			top = i
+		}
+	}
+
+	for i := range Vert {
+		if (i > 0 && i <= top && Degrees[i] < Degrees[i-1]) || (i > top && Degrees[i] > Degrees[i-1]) {
+			return nil
+		}
+	}
+
+	height := Degrees[top]
+	if height == 0 {
+		return nil
+	}
+
+	cut := func(alpha Number) Interval {
+		i := 0
+		for i < top && Degrees[i] < alpha {
+			i++
+		}
+		start := Vert[i]
+		if i > 0 && Degrees[i] > Degrees[i-1] {
+			start = Vert[i-1] + (Vert[i]-Vert[i-1])*(alpha-Degrees[i-1])/(Degrees[i]-Degrees[i-1])
+		}
+
+		j := len(Vert) - 1
+		for j > top && Degrees[j] < alpha {
+			j--
+		}
+		end := Vert[j]
+		if j < len(Vert)-1 && Degrees[j] > Degrees[j+1] {
+			end = Vert[j+1] - (Vert[j+1]-Vert[j])*(alpha-Degrees[j+1])/(Degrees[j]-Degrees[j+1])
+		}
+		return Interval{start, end}
+	}
+
+	result := newSampledT1FS(cut, height, v.PiecewiseLinear)
+	result.Vert[0], result.Vert[len(result.Vert)-1] = Vert[0], Vert[len(Vert)-1]
+	return result
+}
+
+// NewGaussianT1FS builds a Gaussian fuzzy number with given mean and standard
+// deviation.
+func NewGaussianT1FS(Mean, Sigma Number) *T1FS {
+	if Sigma <= 0 {
+		return nil
+	}
+
+	return newSampledT1FS(func(alpha Number) Interval {
+		spread := Sigma * Number(math.Sqrt(-2*math.Log(float64(max(alpha, MembershipCutoff)))))
+		return Interval{Mean - spread, Mean + spread}
+	}, 1, v.Gaussian)
+}
+
+// NewBellT1FS builds a generalized bell fuzzy number 1/(1+|(x-c)/a|^(2b)) with
+// width a, slope b and center c.
+func NewBellT1FS(Width, Slope, Center Number) *T1FS {
+	if Width <= 0 || Slope <= 0 {
+		return nil
+	}
+
+	return newSampledT1FS(func(alpha Number) Interval {
+		alpha = max(alpha, MembershipCutoff)
+		spread := Width * Number(math.Pow(float64((1-alpha)/alpha), float64(1/(2*Slope))))
+		return Interval{Center - spread, Center + spread}
+	}, 1, v.Bell)
+}
+
+func newSampledT1FS(cut func(alpha Number) Interval, height Number, form v.Variants) *T1FS {
+	vert := make([]Number, 2*(CountOfAlfaSlices+1))
+	for i := 0; i <= CountOfAlfaSlices; i++ {
+		bounds := cut(height * Number(i) / Number(CountOfAlfaSlices))
+		vert[i], vert[len(vert)-1-i] = bounds.Start, bounds.End
+	}
+
+	result := NewT1FS(vert...)
+	result.Form, result.Height = form, height
+	return result
+}
+
+func isSampledForm(f v.Variants) bool {
+	return f == v.Gaussian || f == v.Bell || f == v.PiecewiseLinear
+}
+
+// classicForm maps forms given by alpha-cuts to the trapezoid approximating
+// them in types limited to triangles and trapezoids.
+func classicForm(f v.Variants) v.Variants {
+	if isSampledForm(f) {
+		return v.Trapezoid
+	}
+	return f
+}
+
+func (t *T1FS) isSampled() bool {
+	return isSampledForm(t.Form)
+}
+
+func (t *T1FS) height() Number {
+	if t.Height == 0 {
+		return 1
+	}
+	return t.Height
+}
+
+// reshaped returns a set of the same form and height with given vertices.
+func (t *T1FS) reshaped(Vert []Number) *T1FS {
+	result := NewT1FS(Vert...)
+	if result != nil && t.isSampled() {
+		result.Form, result.Height = t.Form, t.Height
+	}
+	return result
+}
+
+// resampled returns the set given by its alpha-cuts at k+1 evenly spaced
+// levels up to height.
+func (t *T1FS) resampled(k int, height Number, form v.Variants) *T1FS {
+	vert := make([]Number, 2*(k+1))
+	for i := 0; i <= k; i++ {
+		bounds := t.MemberFunction(height * Number(i) / Number(k))
+		vert[i], vert[len(vert)-1-i] = bounds.Start, bounds.End
+	}
+
+	result := NewT1FS(vert...)
+	result.Form, result.Height = form, height
+	return result
+}
+
+// aligned brings two sets to the same levels of alpha-cuts, so that their
+// vertices can be combined one by one, unless both are triangles or trapezoids.
+func (t *T1FS) aligned(other *T1FS) (*T1FS, *T1FS) {
+	if !t.isSampled() && !other.isSampled() {
+		return t, other
+	}
+
+	if len(t.Vert) == len(other.Vert) && t.height() == other.height() && t.isSampled() && other.isSampled() {
+		return t, other
+	}
+
+	k := max(len(t.Vert), len(other.Vert), 2*(CountOfAlfaSlices+1))/2 - 1
+	height := min(t.height(), other.height())
+	form := t.Form
+	if !t.isSampled() {
+		form = other.Form
+	}
+	return t.resampled(k, height, form), other.resampled(k, height, form)
+}
+
+// Hedge applies a linguistic hedge raising membership to a power: powers
+// above one concentrate the set ("very"), powers below one dilate it
+// ("more or less").
+func (t *T1FS) Hedge(power Number) *T1FS {
+	if power <= 0 {
+		return nil
+	}
+
+	return newSampledT1FS(func(alpha Number) Interval {
+		return t.MemberFunction(Number(math.Pow(float64(alpha), float64(1/power))))
+	}, Number(math.Pow(float64(t.height()), float64(power))), v.PiecewiseLinear)
+}
+
 func (t *T1FS) CopyEval() Rating {
-	return Rating{NewT1FS(t.Vert...)}
+	return Rating{t.reshaped(t.Vert)}
 }
 
+// MemberFunction returns the alpha-cut of the set, empty above its height.
 func (t *T1FS) MemberFunction(alpha Number) Interval {
-	if len(t.Vert) == 3 {
-		return Interval{t.Vert[0] + (t.Vert[1]-t.Vert[0])*alpha, t.Vert[2] - (t.Vert[2]-t.Vert[1])*alpha}
-	} else {
-		return Interval{t.Vert[0] + (t.Vert[1]-t.Vert[0])*alpha, t.Vert[3] - (t.Vert[3]-t.Vert[2])*alpha}
+	if !t.isSampled() {
+		if len(t.Vert) == 3 {
+			return Interval{t.Vert[0] + (t.Vert[1]-t.Vert[0])*alpha, t.Vert[2] - (t.Vert[2]-t.Vert[1])*alpha}
+		} else {
+			return Interval{t.Vert[0] + (t.Vert[1]-t.Vert[0])*alpha, t.Vert[3] - (t.Vert[3]-t.Vert[2])*alpha}
+		}
+	}
+
+	if alpha > t.height() {
+		return Interval{0, 0}
 	}
+
+	n, k := len(t.Vert), len(t.Vert)/2-1
+	pos := float64(alpha / t.height() * Number(k))
+	i := min(int(pos), k-1)
+	frac := Number(pos) - Number(i)
+	return Interval{t.Vert[i] + (t.Vert[i+1]-t.Vert[i])*frac,
+		t.Vert[n-1-i] + (t.Vert[n-2-i]-t.Vert[n-1-i])*frac}
 }
 
 func (t *T1FS) GetType() string {
@@ -71,9 +272,25 @@ func (t *T1FS) ConvertToInterval() Interval {
 	return t.Decompose
 }
 
+// ConvertToGrey takes the support of the set as bounds of the grey number.
+func (t *T1FS) ConvertToGrey() Grey {
+	return Grey{t.Vert[0], t.Vert[len(t.Vert)-1]}
+}
+
 func (t *T1FS) ConvertToT1FS(f v.Variants) *T1FS {
 	if f == t.Form || f == v.Default {
 		return t
+	} else if isSampledForm(f) {
+		if t.isSampled() {
+			return t
+		}
+		return t.resampled(CountOfAlfaSlices, 1, v.PiecewiseLinear)
+	} else if t.isSampled() {
+		top := t.MemberFunction(t.height())
+		if f == v.Triangle {
+			return NewT1FS(t.Vert[0], (top.Start+top.End)/2, t.Vert[len(t.Vert)-1])
+		}
+		return NewT1FS(t.Vert[0], top.Start, top.End, t.Vert[len(t.Vert)-1])
 	} else if f == v.Triangle && t.Form == v.Trapezoid {
 		return NewT1FS(t.Vert[0], (t.Vert[1]+t.Vert[2])/2, t.Vert[3])
 	} else {
@@ -82,6 +299,13 @@ func (t *T1FS) ConvertToT1FS(f v.Variants) *T1FS {
 }
 
 func (t *T1FS) ConvertToAIFS(f v.Variants) *AIFS {
+	if t.isSampled() || isSampledForm(f) {
+		if f = classicForm(f); f == v.Default {
+			f = v.Trapezoid
+		}
+		return t.ConvertToT1FS(f).ConvertToAIFS(f)
+	}
+
 	if f == t.Form || f == v.Default {
 		return NewAIFS(0.0, t.Vert...)
 	} else if f == v.Triangle && t.Form == v.Trapezoid {
@@ -91,7 +315,39 @@ func (t *T1FS) ConvertToAIFS(f v.Variants) *AIFS {
 	}
 }
 
+func (t *T1FS) ConvertToPFS(f v.Variants) *PFS {
+	if t.isSampled() || isSampledForm(f) {
+		if f = classicForm(f); f == v.Default {
+			f = v.Trapezoid
+		}
+		return t.ConvertToT1FS(f).ConvertToPFS(f)
+	}
+
+	if f == t.Form || f == v.Default {
+		return NewPFS(1.0, 0.0, t.Vert...)
+	} else if f == v.Triangle && t.Form == v.Trapezoid {
+		return NewPFS(1.0, 0.0, t.Vert[0], (t.Vert[1]+t.Vert[2])/2, t.Vert[3])
+	} else {
+		return NewPFS(1.0, 0.0, t.Vert[0], t.Vert[1], t.Vert[1], t.Vert[2])
+	}
+}
+
+func (t *T1FS) ConvertToHFS(_ v.Variants) *HFS {
+	return Defuzzify(t).ConvertToHFS(v.Default)
+}
+
+func (t *T1FS) ConvertToNS(_ v.Variants) *NS {
+	return Defuzzify(t).ConvertToNS(v.Default)
+}
+
 func (t *T1FS) ConvertToIT2FS(f v.Variants) *IT2FS {
+	if t.isSampled() || isSampledForm(f) {
+		if f = classicForm(f); f == v.Default {
+			f = v.Trapezoid
+		}
+		return t.ConvertToT1FS(f).ConvertToIT2FS(f)
+	}
+
 	if (f == t.Form || f == v.Default) && t.Form == v.Triangle {
 		return NewIT2FS([]Interval{{t.Vert[0], t.Vert[0]}, {t.Vert[2], t.Vert[2]}},
 			[]Number{t.Vert[1]})
@@ -112,7 +368,7 @@ func (t *T1FS) GetForm() v.Variants {
 }
 
 func (t *T1FS) Weighted(Weight Evaluated) Rating {
-	wt := NewT1FS(t.Vert...)
+	wt := t.reshaped(t.Vert)
 	for i := range t.Vert {
 		wt.Vert[i] = t.Vert[i].Weighted(Weight).ConvertToNumber()
 	}
@@ -129,19 +385,20 @@ func (t *T1FS) DiffNumber(other Evaluated, variants v.Variants) (Number, error)
 		i := t.ConvertToInterval()
 		return i.DiffNumber(other, variants)
 	} else if other.GetType() == t.GetType() {
+		a, b := t.aligned(other.ConvertToT1FS(v.Default))
 		d := Number(0)
 		if variants == v.SqrtDistance {
-			for i := range t.Vert {
-				d += Number(math.Pow(float64(t.Vert[i]-other.ConvertToT1FS(v.Default).Vert[i]), 2))
+			for i := range a.Vert {
+				d += Number(math.Pow(float64(a.Vert[i]-b.Vert[i]), 2))
 			}
 		} else if variants == v.CbrtDistance {
-			for i := range t.Vert {
-				d += Number(math.Abs(math.Pow(float64(t.Vert[i]-other.ConvertToT1FS(v.Default).Vert[i]), 3)))
+			for i := range a.Vert {
+				d += Number(math.Abs(math.Pow(float64(a.Vert[i]-b.Vert[i]), 3)))
 			}
 		} else {
 			return 0, v.InvalidCaseOfOperation
 		}
-		return d / Number(len(t.Vert)), nil
+		return d / Number(len(a.Vert)), nil
 	} else {
 		return 0, v.IncompatibleTypes
 	}
@@ -158,9 +415,14 @@ func (t *T1FS) DiffInterval(other Interval, typeOfCriterion bool, variants v.Var
 }
 
 func (t *T1FS) Sum(other Evaluated) Rating {
-	ret := NewT1FS(t.Vert...)
-	for i := range t.Vert {
-		ret.Vert[i] = t.Vert[i] + other.ConvertToT1FS(v.Default).Vert[i]
+	o := other.ConvertToT1FS(v.Default)
+	a, b := t.aligned(o)
+	ret := a.reshaped(a.Vert)
+	for i := range a.Vert {
+		ret.Vert[i] = a.Vert[i] + b.Vert[i]
+	}
+	if ret.isSampled() && t.Form != o.Form {
+		ret.Form = v.PiecewiseLinear
 	}
 	return Rating{ret}
 }
@@ -170,8 +432,13 @@ func (t *T1FS) Equals(other Evaluated) bool {
 		return false
 	}
 
+	o := other.ConvertToT1FS(v.Default)
+	if len(o.Vert) != len(t.Vert) || !t.height().Equals(o.height()) {
+		return false
+	}
+
 	for i := range t.Vert {
-		if t.Vert[i].Equals(other.ConvertToT1FS(v.Default).Vert[i]) == false {
+		if t.Vert[i].Equals(o.Vert[i]) == false {
 			return false
 		}
 	}
@@ -0,0 +1,156 @@
+package eval
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	v "webApp/lib/variables"
+)
+
+// TwoTuple is a linguistic 2-tuple (s_i, α) of Herrera and Martínez: a term of
+// a linguistic scale and the symbolic translation α ∈ [-0.5, 0.5) from it,
+// which keeps results of aggregation in the vocabulary of experts without loss.
+type TwoTuple struct {
+	Term  int    `json:"term"`
+	Mark  string `json:"mark"`
+	Alpha Number `json:"alpha"`
+}
+
+func (t TwoTuple) String() string {
+	return fmt.Sprintf("%s, %.2f", t.Mark, float64(t.Alpha))
+}
+
+// granularity is the index of the last term of the scale.
+func (l *LinguisticScale) granularity() (int, error) {
+	if len(l.Marks) == 0 {
+		return 0, v.EmptyValues
+	}
+
+	if len(l.Ratings) != 0 && len(l.Ratings) != len(l.Marks) {
+		return 0, v.InvalidSize
+	}
+	return len(l.Marks) - 1, nil
+}
+
+// Delta is the Δ transformation turning a value β ∈ [0, g] of the scale of
+// g+1 terms into the nearest term and the translation from it.
+func (l *LinguisticScale) Delta(beta Number) (TwoTuple, error) {
+	g, err := l.granularity()
+	if err != nil {
+		return TwoTuple{}, err
+	}
+
+	if beta < -1e-9 || beta > Number(g)+1e-9 {
+		return TwoTuple{}, v.OutOfBounds
+	}
+	beta = Number(math.Min(math.Max(float64(beta), 0), float64(g)))
+
+	term := int(math.Floor(float64(beta) + 0.5))
+	if term > g {
+		term = g
+	}
+	return TwoTuple{Term: term, Mark: l.Marks[term], Alpha: beta - Number(term)}, nil
+}
+
+// DeltaInverse is the Δ⁻¹ transformation returning the value β of a 2-tuple.
+func (l *LinguisticScale) DeltaInverse(t TwoTuple) Number {
+	return Number(t.Term) + t.Alpha
+}
+
+// Beta places a rating on the scale. A linguistic rating with a mark of the
+// scale is its term; any other rating is interpolated between the terms
+// whose defuzzified ratings surround it and clamped to the ends of the scale.
+func (l *LinguisticScale) Beta(e Evaluated) (Number, error) {
+	g, err := l.granularity()
+	if err != nil {
+		return 0, err
+	}
+
+	if ling, ok := e.(Linguistic); ok {
+		for i := range l.Marks {
+			if l.Marks[i] == ling.Mark {
+				return Number(i), nil
+			}
+		}
+	} else if r, ok := e.(Rating); ok {
+		if ling, ok := r.Evaluated.(Linguistic); ok {
+			return l.Beta(ling)
+		}
+	}
+
+	if len(l.Ratings) == 0 {
+		return 0, v.EmptyValues
+	}
+
+	x := Defuzzify(e)
+	if x <= Defuzzify(l.Ratings[0]) || g == 0 {
+		return 0, nil
+	}
+
+	for i := 1; i <= g; i++ {
+		low, high := Defuzzify(l.Ratings[i-1]), Defuzzify(l.Ratings[i])
+		if x <= high {
+			if high == low {
+				return Number(i), nil
+			}
+			return Number(i-1) + (x-low)/(high-low), nil
+		}
+	}
+	return Number(g), nil
+}
+
+// Express turns a rating into the 2-tuple of the scale closest to it.
+func (l *LinguisticScale) Express(e Evaluated) (TwoTuple, error) {
+	beta, err := l.Beta(e)
+	if err != nil {
+		return TwoTuple{}, err
+	}
+	return l.Delta(beta)
+}
+
+// ExpressUnit turns a value of the unit interval, such as a closeness
+// coefficient, into a 2-tuple, mapping the interval onto the whole scale.
+func (l *LinguisticScale) ExpressUnit(x Number) (TwoTuple, error) {
+	g, err := l.granularity()
+	if err != nil {
+		return TwoTuple{}, err
+	}
+	return l.Delta(Number(math.Min(math.Max(float64(x), 0), 1)) * Number(g))
+}
+
+// WeightedAverage is the 2-tuple weighted average Δ(Σ w_i·β_i / Σ w_i).
+func (l *LinguisticScale) WeightedAverage(values []TwoTuple, weights []Number) (TwoTuple, error) {
+	if len(values) == 0 {
+		return TwoTuple{}, v.EmptyValues
+	}
+
+	if len(values) != len(weights) {
+		return TwoTuple{}, v.InvalidSize
+	}
+
+	sum, weightSum := Number(0), Number(0)
+	for i := range values {
+		sum += l.DeltaInverse(values[i]) * weights[i]
+		weightSum += weights[i]
+	}
+
+	if weightSum == 0 {
+		return TwoTuple{}, v.EmptyValues
+	}
+	return l.Delta(sum / weightSum)
+}
+
+// OWA is the 2-tuple ordered weighted averaging operator, which puts i-th
+// weight on i-th largest value rather than on i-th argument.
+func (l *LinguisticScale) OWA(values []TwoTuple, weights []Number) (TwoTuple, error) {
+	if len(values) != len(weights) {
+		return TwoTuple{}, v.InvalidSize
+	}
+
+	ordered := make([]TwoTuple, len(values))
+	copy(ordered, values)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return l.DeltaInverse(ordered[i]) > l.DeltaInverse(ordered[j])
+	})
+	return l.WeightedAverage(ordered, weights)
+}
@@ -0,0 +1,180 @@
+package eval
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	v "webApp/lib/variables"
+)
+
+// ZNumber is a fuzzy restriction on a value together with a fuzzy
+// reliability of that restriction on the unit scale.
+type ZNumber struct {
+	Restriction *T1FS `json:"restriction"`
+	Reliability *T1FS `json:"reliability"`
+}
+
+func NewZNumber(Restriction, Reliability *T1FS) *ZNumber {
+	if Restriction == nil || Reliability == nil {
+		return nil
+	}
+
+	if Reliability.Vert[0] < 0 || Reliability.Vert[len(Reliability.Vert)-1] > 1 {
+		return nil
+	}
+
+	restriction, reliability := Restriction.reshaped(Restriction.Vert), Reliability.reshaped(Reliability.Vert)
+	if restriction == nil || reliability == nil {
+		return nil
+	}
+	return &ZNumber{Restriction: restriction, Reliability: reliability}
+}
+
+// Expectation is the centroid of the reliability, the crisp weight it puts
+// on the restriction.
+func (z *ZNumber) Expectation() Number {
+	if z.Reliability.isSampled() {
+		return Defuzzify(z.Reliability)
+	}
+
+	vert := z.Reliability.Vert
+	a, b, c, d := vert[0], vert[1], vert[1], vert[2]
+	if len(vert) == 4 {
+		c, d = vert[2], vert[3]
+	}
+
+	denominator := 3 * (d + c - a - b)
+	if denominator == 0 {
+		return a
+	}
+	return (d*d + c*c + c*d - a*a - b*b - a*b) / denominator
+}
+
+// weighted is the regular fuzzy number equivalent to the Z-number, the
+// restriction scaled by the square root of the reliability expectation.
+func (z *ZNumber) weighted() *T1FS {
+	scale := Number(math.Sqrt(math.Max(float64(z.Expectation()), 0)))
+	vert := make([]Number, len(z.Restriction.Vert))
+	for i := range vert {
+		vert[i] = z.Restriction.Vert[i] * scale
+	}
+	return z.Restriction.reshaped(vert)
+}
+
+func (z *ZNumber) UnmarshalJSON(data []byte) error {
+	var raw = struct {
+		Restriction T1FS `json:"restriction"`
+		Reliability T1FS `json:"reliability"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	zn := NewZNumber(&raw.Restriction, &raw.Reliability)
+	if zn == nil {
+		return v.InvalidMembership
+	}
+	*z = *zn
+	return nil
+}
+
+func (z *ZNumber) CopyEval() Rating {
+	return Rating{NewZNumber(z.Restriction, z.Reliability)}
+}
+
+func (z *ZNumber) GetType() string {
+	return reflect.TypeOf(z).String()
+}
+
+func (z *ZNumber) ConvertToNumber() Number {
+	return z.weighted().ConvertToNumber()
+}
+
+func (z *ZNumber) ConvertToInterval() Interval {
+	return z.weighted().ConvertToInterval()
+}
+
+func (z *ZNumber) ConvertToGrey() Grey {
+	return z.weighted().ConvertToGrey()
+}
+
+func (z *ZNumber) ConvertToT1FS(f v.Variants) *T1FS {
+	return z.weighted().ConvertToT1FS(f)
+}
+
+func (z *ZNumber) ConvertToAIFS(f v.Variants) *AIFS {
+	return z.weighted().ConvertToAIFS(f)
+}
+
+func (z *ZNumber) ConvertToPFS(f v.Variants) *PFS {
+	return z.weighted().ConvertToPFS(f)
+}
+
+func (z *ZNumber) ConvertToHFS(f v.Variants) *HFS {
+	return z.weighted().ConvertToHFS(f)
+}
+
+func (z *ZNumber) ConvertToNS(f v.Variants) *NS {
+	return z.weighted().ConvertToNS(f)
+}
+
+func (z *ZNumber) ConvertToIT2FS(f v.Variants) *IT2FS {
+	return z.weighted().ConvertToIT2FS(f)
+}
+
+func (z *ZNumber) GetForm() v.Variants {
+	return z.Restriction.Form
+}
+
+func (z *ZNumber) Weighted(Weight Evaluated) Rating {
+	return z.weighted().Weighted(Weight)
+}
+
+func (z *ZNumber) String() string {
+	return "Z(" + z.Restriction.String() + ", " + z.Reliability.String() + ")"
+}
+
+func (z *ZNumber) DiffNumber(other Evaluated, variants v.Variants) (Number, error) {
+	if other.GetType() == z.GetType() {
+		other = other.ConvertToT1FS(v.Default)
+	}
+	return z.weighted().DiffNumber(other, variants)
+}
+
+func (z *ZNumber) DiffInterval(other Interval, typeOfCriterion bool, variants v.Variants) (Interval, error) {
+	return z.weighted().DiffInterval(other, typeOfCriterion, variants)
+}
+
+func (z *ZNumber) Sum(other Evaluated) Rating {
+	return z.weighted().Sum(other)
+}
+
+func (z *ZNumber) Equals(other Evaluated) bool {
+	if other.GetType() != z.GetType() {
+		return false
+	}
+
+	o := asZNumber(other)
+	return o != nil && z.Restriction.Equals(o.Restriction) && z.Reliability.Equals(o.Reliability)
+}
+
+func asZNumber(e Evaluated) *ZNumber {
+	switch t := e.(type) {
+	case *ZNumber:
+		return t
+	case Rating:
+		return asZNumber(t.Evaluated)
+	case Linguistic:
+		return asZNumber(t.Evaluated)
+	}
+	return nil
+}
+
+// Reliability is the expected reliability of a rating; anything but
+// a Z-number is taken as fully reliable.
+func Reliability(e Evaluated) Number {
+	if z := asZNumber(e); z != nil {
+		return z.Expectation()
+	}
+	return 1
+}
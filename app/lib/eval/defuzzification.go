@@ -0,0 +1,169 @@
+package eval
+
+import (
+	"math"
+	v "webApp/lib/variables"
+)
+
+// Crisp defuzzifies a rating with the given method. Type-1, intuitionistic
+// and interval type-2 fuzzy sets support every method; other ratings and
+// the α-cut average fall back to Defuzzify, so all methods give values on
+// the scale of the ratings.
+func Crisp(e Evaluated, method v.Variants) Number {
+	if method == v.AlphaCutAverage {
+		return Defuzzify(e)
+	}
+
+	if e.GetType() == (&T1FS{}).GetType() {
+		return e.ConvertToT1FS(v.Default).Crisp(method)
+	} else if e.GetType() == (&AIFS{}).GetType() {
+		return e.ConvertToAIFS(v.Default).Crisp(method)
+	} else if e.GetType() == (&IT2FS{}).GetType() {
+		return e.ConvertToIT2FS(v.Default).Crisp(method)
+	}
+	return Defuzzify(e)
+}
+
+// Crisp defuzzifies the set with the given method.
+func (t *T1FS) Crisp(method v.Variants) Number {
+	if method == v.Centroid {
+		return t.centroid()
+	} else if method == v.Bisector {
+		return t.bisector()
+	} else if method == v.MeanOfMaxima {
+		core := t.MemberFunction(t.height())
+		return (core.Start + core.End) / 2
+	} else if method == v.GradedMeanIntegration {
+		h := t.height()
+		return t.integrate(func(alpha Number, cut Interval) Number {
+			return alpha * (cut.Start + cut.End) / 2
+		}) / (h * h / 2)
+	}
+	return t.ConvertToNumber()
+}
+
+// AIFS shares the shape of its membership function with T1FS, which
+// hesitancy only scales, so every method but the α-cut average ignores it.
+func (a *AIFS) Crisp(method v.Variants) Number {
+	if method == v.AlphaCutAverage {
+		return a.ConvertToNumber()
+	}
+	return a.ConvertToT1FS(v.Default).Crisp(method)
+}
+
+// Crisp defuzzifies the type-reduced centroid, other methods average values
+// of upper and lower membership functions.
+func (t *IT2FS) Crisp(method v.Variants) Number {
+	if method == v.Centroid {
+		c := t.Centroid()
+		return (c.Start + c.End) / 2
+	} else if method == v.AlphaCutAverage {
+		return t.ConvertToNumber()
+	}
+	return (t.Upper().Crisp(method) + t.Lower().Crisp(method)) / 2
+}
+
+// integrate applies Simpson's rule to a function of α-cuts over levels
+// from zero to the height of the set.
+func (t *T1FS) integrate(f func(alpha Number, cut Interval) Number) Number {
+	n := CountOfAlfaSlices + CountOfAlfaSlices%2
+	step := t.height() / Number(n)
+
+	sum := Number(0)
+	for k := 0; k <= n; k++ {
+		alpha := step * Number(k)
+		coeff := Number(2 + 2*(k%2))
+		if k == 0 || k == n {
+			coeff = 1
+		}
+		sum += coeff * f(alpha, t.MemberFunction(alpha))
+	}
+	return sum * step / 3
+}
+
+// area is the area under the membership function.
+func (t *T1FS) area() Number {
+	return t.integrate(func(_ Number, cut Interval) Number {
+		return cut.End - cut.Start
+	})
+}
+
+// centroid is the center of gravity ∫xμ(x)dx / ∫μ(x)dx, both integrals taken
+// over α-cuts, where the moment of a cut [L, R] is (R² - L²) / 2.
+func (t *T1FS) centroid() Number {
+	area := t.area()
+	if area < 1e-9 {
+		return t.Crisp(v.MeanOfMaxima)
+	}
+
+	return t.integrate(func(_ Number, cut Interval) Number {
+		return (cut.End*cut.End - cut.Start*cut.Start) / 2
+	}) / area
+}
+
+// bisector is the point splitting the area under the membership function
+// in halves, found by bisection over the support.
+func (t *T1FS) bisector() Number {
+	half := t.area() / 2
+	if half < 1e-9 {
+		return t.Crisp(v.MeanOfMaxima)
+	}
+
+	support := t.MemberFunction(0)
+	low, high := support.Start, support.End
+	for i := 0; i < 60 && high-low > 1e-9; i++ {
+		x := (low + high) / 2
+		left := t.integrate(func(_ Number, cut Interval) Number {
+			return Number(math.Max(0, float64(min(x, cut.End)-cut.Start)))
+		})
+
+		if left < half {
+			low = x
+		} else {
+			high = x
+		}
+	}
+	return (low + high) / 2
+}
+
+// Degree is the membership degree of x in the set.
+func (t *T1FS) Degree(x Number) Number {
+	n := len(t.Vert)
+	left, right := t.Vert[:2], []Number{t.Vert[n-1], t.Vert[n-2]}
+	if t.isSampled() {
+		left, right = t.Vert[:n/2], make([]Number, n/2)
+		for i := range right {
+			right[i] = t.Vert[n-1-i]
+		}
+	}
+
+	if x < left[0] || x > right[0] {
+		return 0
+	}
+
+	step := t.height() / Number(len(left)-1)
+	for i := 1; i < len(left); i++ {
+		if x < left[i] {
+			return step * (Number(i-1) + (x-left[i-1])/(left[i]-left[i-1]))
+		}
+	}
+	for i := 1; i < len(right); i++ {
+		if x > right[i] {
+			return step * (Number(i-1) + (right[i-1]-x)/(right[i-1]-right[i]))
+		}
+	}
+	return t.height()
+}
+
+// Upper is the upper membership function bounding the footprint of
+// uncertainty from above, resting on the outer ends of both feet.
+func (t *IT2FS) Upper() *T1FS {
+	vert := append([]Number{min(t.Bottom[0].Start, t.Bottom[0].End)}, t.Upward...)
+	return NewT1FS(append(vert, max(t.Bottom[1].Start, t.Bottom[1].End))...)
+}
+
+// Lower is the lower membership function resting on the inner ends of feet.
+func (t *IT2FS) Lower() *T1FS {
+	vert := append([]Number{max(t.Bottom[0].Start, t.Bottom[0].End)}, t.Upward...)
+	return NewT1FS(append(vert, min(t.Bottom[1].Start, t.Bottom[1].End))...)
+}
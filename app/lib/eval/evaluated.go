@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
-	"strings"
 	v "webApp/lib/variables"
 )
 
@@ -12,8 +11,12 @@ type Evaluated interface {
 	GetType() string
 	ConvertToNumber() Number
 	ConvertToInterval() Interval
+	ConvertToGrey() Grey
 	ConvertToT1FS(f v.Variants) *T1FS
 	ConvertToAIFS(f v.Variants) *AIFS
+	ConvertToPFS(f v.Variants) *PFS
+	ConvertToHFS(f v.Variants) *HFS
+	ConvertToNS(f v.Variants) *NS
 	ConvertToIT2FS(f v.Variants) *IT2FS
 	GetForm() v.Variants
 	Weighted(Weight Evaluated) Rating
@@ -40,12 +43,22 @@ func (r *Rating) MarshalJSON() ([]byte, error) {
 		return json.Marshal(r.Evaluated.ConvertToNumber())
 	case Interval:
 		return json.Marshal(r.Evaluated.ConvertToInterval())
+	case Grey:
+		return json.Marshal(r.Evaluated.ConvertToGrey())
 	case *T1FS:
 		return json.Marshal(r.Evaluated.ConvertToT1FS(v.Default))
 	case *IT2FS:
 		return json.Marshal(r.Evaluated.ConvertToIT2FS(v.Default))
 	case *AIFS:
 		return json.Marshal(r.Evaluated.ConvertToAIFS(v.Default))
+	case *PFS:
+		return json.Marshal(r.Evaluated.ConvertToPFS(v.Default))
+	case *HFS:
+		return json.Marshal(r.Evaluated.ConvertToHFS(v.Default))
+	case *NS:
+		return json.Marshal(r.Evaluated.ConvertToNS(v.Default))
+	case *ZNumber:
+		return json.Marshal(r.Evaluated)
 	case Linguistic:
 		return json.Marshal(r.Evaluated)
 	case nil:
@@ -55,28 +68,65 @@ func (r *Rating) MarshalJSON() ([]byte, error) {
 	}
 }
 
+// UnmarshalJSON tells the type of a rating by the top-level keys of its
+// object, so that labels or nested values mentioning a key of another type
+// do not mislead it; a bare number is a crisp rating.
 func (r *Rating) UnmarshalJSON(data []byte) error {
-	if strings.Contains(string(data), "mark") {
-		var m = struct {
-			Mark string `json:"mark"`
-		}{}
-		if err := json.Unmarshal(data, &m); err != nil {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		var n float64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return v.IncompatibleTypes
+		}
+		r.Evaluated = Number(n)
+		return nil
+	}
+
+	if _, ok := fields["mark"]; ok {
+		var l Linguistic
+		if err := json.Unmarshal(fields["mark"], &l.Mark); err != nil {
+			return err
+		}
+		if rating, ok := fields["eval"]; ok {
+			if err := json.Unmarshal(rating, &l.Rating); err != nil {
+				return err
+			}
+		}
+		r.Evaluated = l
+		return nil
+	}
+
+	if _, ok := fields["restriction"]; ok {
+		var z ZNumber
+		if err := json.Unmarshal(data, &z); err == nil {
+			r.Evaluated = &z
+			return nil
+		} else {
 			return err
 		}
+	}
+
+	if _, ok := fields["hesitant"]; ok {
+		var h HFS
+		if err := json.Unmarshal(data, &h); err == nil {
+			r.Evaluated = &h
+			return nil
+		} else {
+			return err
+		}
+	}
 
-		dataTmp := []byte(strings.Replace(string(data), "mark", "smt", -1))
-		var e = struct {
-			Eval Rating `json:"eval"`
-		}{}
-		if err := json.Unmarshal(dataTmp, &e); err == nil {
-			r.Evaluated = Linguistic{Mark: m.Mark, Rating: e.Eval}
+	if _, ok := fields["truth"]; ok {
+		var n NS
+		if err := json.Unmarshal(data, &n); err == nil {
+			r.Evaluated = &n
 			return nil
 		} else {
 			return err
 		}
 	}
 
-	if strings.Contains(string(data), "bottom") {
+	if _, ok := fields["bottom"]; ok {
 		var t2 = IT2FS{Bottom: make([]Interval, 0), Upward: make([]Number, 0)}
 		if err := json.Unmarshal(data, &t2); err == nil {
 			r.Evaluated = &t2
@@ -86,7 +136,21 @@ func (r *Rating) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	if strings.Contains(string(data), "pi") {
+	if _, ok := fields["nu"]; ok {
+		var p = PFS{T1FS: &T1FS{Decompose: Interval{NumbersMin, NumbersMin}, Vert: make([]Number, 0)}}
+		if err := json.Unmarshal(data, &p); err != nil {
+			return err
+		}
+
+		pfs := NewPFS(p.Mu, p.Nu, p.Vert...)
+		if pfs == nil {
+			return v.InvalidMembership
+		}
+		r.Evaluated = pfs
+		return nil
+	}
+
+	if _, ok := fields["pi"]; ok {
 		var a = AIFS{T1FS: &T1FS{Vert: make([]Number, 0)}}
 		if err := json.Unmarshal(data, &a); err == nil && len(a.Vert) > 0 {
 			r.Evaluated = &a
@@ -96,9 +160,12 @@ func (r *Rating) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	if strings.Contains(string(data), "vert") {
+	if _, ok := fields["vert"]; ok {
 		var t1 = T1FS{Vert: make([]Number, 0)}
 		if err := json.Unmarshal(data, &t1); err == nil && len(t1.Vert) > 0 {
+			if t1.isSampled() && (len(t1.Vert) < 4 || len(t1.Vert)%2 != 0) {
+				return v.InvalidMembership
+			}
 			r.Evaluated = &t1
 			return nil
 		} else {
@@ -106,7 +173,17 @@ func (r *Rating) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	if strings.Contains(string(data), "start") {
+	if _, ok := fields["lower"]; ok {
+		var g Grey
+		if err := json.Unmarshal(data, &g); err == nil {
+			r.Evaluated = NewGrey(g.Lower, g.Upper)
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	if _, ok := fields["start"]; ok {
 		var i Interval
 		if err := json.Unmarshal(data, &i); err == nil {
 			r.Evaluated = i
@@ -116,13 +193,6 @@ func (r *Rating) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	var n float64
-	err := json.Unmarshal(data, &n)
-	if err == nil {
-		r.Evaluated = Number(n)
-		return nil
-	}
-
 	return v.IncompatibleTypes
 }
 
@@ -138,12 +208,12 @@ func Max[T Evaluated](a, b T) Rating {
 	}
 
 	if a.GetType() == (&T1FS{}).GetType() {
-		maxVert := make([]Number, len(a.ConvertToT1FS(v.Default).Vert))
+		at, bt := a.ConvertToT1FS(v.Default).aligned(b.ConvertToT1FS(v.Default))
+		maxVert := make([]Number, len(at.Vert))
 		for i := range maxVert {
-			maxVert[i] = Number(math.Max(float64(a.ConvertToT1FS(v.Default).Vert[i]),
-				float64(b.ConvertToT1FS(v.Default).Vert[i])))
+			maxVert[i] = Number(math.Max(float64(at.Vert[i]), float64(bt.Vert[i])))
 		}
-		return Rating{NewT1FS(maxVert...)}
+		return Rating{at.reshaped(maxVert)}
 	}
 
 	if a.GetType() == (&AIFS{}).GetType() {
@@ -156,6 +226,50 @@ func Max[T Evaluated](a, b T) Rating {
 		return Rating{NewAIFS(minPi, maxVert...)}
 	}
 
+	if a.GetType() == (&HFS{}).GetType() {
+		ah, bh := a.ConvertToHFS(v.Default), b.ConvertToHFS(v.Default)
+		av, bv := ah.normalizedPair(bh)
+		for i := range av {
+			av[i] = Number(math.Max(float64(av[i]), float64(bv[i])))
+		}
+		return Rating{NewHFS(av...).withHesitance(ah.Optimistic || bh.Optimistic)}
+	}
+
+	if a.GetType() == (&NS{}).GetType() {
+		an, bn := a.ConvertToNS(v.Default), b.ConvertToNS(v.Default)
+		return Rating{NewIntervalNS(Max(an.Truth, bn.Truth).ConvertToInterval(),
+			Min(an.Indeterminacy, bn.Indeterminacy).ConvertToInterval(), Min(an.Falsity, bn.Falsity).ConvertToInterval())}
+	}
+
+	if a.GetType() == (&PFS{}).GetType() {
+		ap, bp := a.ConvertToPFS(v.Default), b.ConvertToPFS(v.Default)
+		maxVert := make([]Number, len(ap.Vert))
+		for i := range maxVert {
+			maxVert[i] = Number(math.Max(float64(ap.Vert[i]), float64(bp.Vert[i])))
+		}
+		maxMu := Number(math.Max(float64(ap.Mu), float64(bp.Mu)))
+		minNu := Number(math.Min(float64(ap.Nu), float64(bp.Nu)))
+		return Rating{NewPFS(maxMu, minNu, maxVert...)}
+	}
+
+	if a.GetType() == (Grey{}).GetType() {
+		ag, bg := a.ConvertToGrey(), b.ConvertToGrey()
+		return Rating{Grey{Number(math.Max(float64(ag.Lower), float64(bg.Lower))),
+			Number(math.Max(float64(ag.Upper), float64(bg.Upper)))}}
+	}
+
+	if a.GetType() == (&IT2FS{}).GetType() {
+		at, bt := a.ConvertToIT2FS(v.Default), b.ConvertToIT2FS(a.GetForm())
+		result := NewIT2FS(at.Bottom, at.Upward)
+		for i := range result.Bottom {
+			result.Bottom[i] = Max(at.Bottom[i], bt.Bottom[i]).ConvertToInterval()
+		}
+		for i := range result.Upward {
+			result.Upward[i] = Number(math.Max(float64(at.Upward[i]), float64(bt.Upward[i])))
+		}
+		return Rating{result}
+	}
+
 	fmt.Println("Call deprecated method max")
 	return Rating{nil}
 }
@@ -172,12 +286,12 @@ func Min[T Evaluated](a, b T) Rating {
 	}
 
 	if a.GetType() == (&T1FS{}).GetType() {
-		minVert := make([]Number, len(a.ConvertToT1FS(v.Default).Vert))
+		at, bt := a.ConvertToT1FS(v.Default).aligned(b.ConvertToT1FS(v.Default))
+		minVert := make([]Number, len(at.Vert))
 		for i := range minVert {
-			minVert[i] = Number(math.Min(float64(a.ConvertToT1FS(v.Default).Vert[i]),
-				float64(b.ConvertToT1FS(v.Default).Vert[i])))
+			minVert[i] = Number(math.Min(float64(at.Vert[i]), float64(bt.Vert[i])))
 		}
-		return Rating{NewT1FS(minVert...)}
+		return Rating{at.reshaped(minVert)}
 	}
 
 	if a.GetType() == (&AIFS{}).GetType() {
@@ -190,14 +304,104 @@ func Min[T Evaluated](a, b T) Rating {
 		return Rating{NewAIFS(maxPi, minVert...)}
 	}
 
+	if a.GetType() == (&HFS{}).GetType() {
+		ah, bh := a.ConvertToHFS(v.Default), b.ConvertToHFS(v.Default)
+		av, bv := ah.normalizedPair(bh)
+		for i := range av {
+			av[i] = Number(math.Min(float64(av[i]), float64(bv[i])))
+		}
+		return Rating{NewHFS(av...).withHesitance(ah.Optimistic || bh.Optimistic)}
+	}
+
+	if a.GetType() == (&NS{}).GetType() {
+		an, bn := a.ConvertToNS(v.Default), b.ConvertToNS(v.Default)
+		return Rating{NewIntervalNS(Min(an.Truth, bn.Truth).ConvertToInterval(),
+			Max(an.Indeterminacy, bn.Indeterminacy).ConvertToInterval(), Max(an.Falsity, bn.Falsity).ConvertToInterval())}
+	}
+
+	if a.GetType() == (&PFS{}).GetType() {
+		ap, bp := a.ConvertToPFS(v.Default), b.ConvertToPFS(v.Default)
+		minVert := make([]Number, len(ap.Vert))
+		for i := range minVert {
+			minVert[i] = Number(math.Min(float64(ap.Vert[i]), float64(bp.Vert[i])))
+		}
+		minMu := Number(math.Min(float64(ap.Mu), float64(bp.Mu)))
+		maxNu := Number(math.Max(float64(ap.Nu), float64(bp.Nu)))
+		return Rating{NewPFS(minMu, maxNu, minVert...)}
+	}
+
+	if a.GetType() == (Grey{}).GetType() {
+		ag, bg := a.ConvertToGrey(), b.ConvertToGrey()
+		return Rating{Grey{Number(math.Min(float64(ag.Lower), float64(bg.Lower))),
+			Number(math.Min(float64(ag.Upper), float64(bg.Upper)))}}
+	}
+
+	if a.GetType() == (&IT2FS{}).GetType() {
+		at, bt := a.ConvertToIT2FS(v.Default), b.ConvertToIT2FS(a.GetForm())
+		result := NewIT2FS(at.Bottom, at.Upward)
+		for i := range result.Bottom {
+			result.Bottom[i] = Min(at.Bottom[i], bt.Bottom[i]).ConvertToInterval()
+		}
+		for i := range result.Upward {
+			result.Upward[i] = Number(math.Min(float64(at.Upward[i]), float64(bt.Upward[i])))
+		}
+		return Rating{result}
+	}
+
 	fmt.Println("Call deprecated method min")
 	return Rating{nil}
 }
 
+func Defuzzify(e Evaluated) Number {
+	var unit Evaluated
+	if e.GetType() == (&T1FS{}).GetType() {
+		t := e.ConvertToT1FS(v.Default)
+		ones := make([]Number, len(t.Vert))
+		for i := range ones {
+			ones[i] = 1
+		}
+		unit = t.reshaped(ones)
+	} else if e.GetType() == (&AIFS{}).GetType() {
+		unit = NewAIFS(e.ConvertToAIFS(v.Default).Pi, Number(1).ConvertToAIFS(e.GetForm()).Vert...)
+	} else if e.GetType() == (&PFS{}).GetType() {
+		p := e.ConvertToPFS(v.Default)
+		unit = NewPFS(p.Mu, p.Nu, Number(1).ConvertToPFS(e.GetForm()).Vert...)
+	} else if e.GetType() == (&IT2FS{}).GetType() {
+		unit = Number(1).ConvertToIT2FS(e.GetForm())
+	} else if e.GetType() == (&ZNumber{}).GetType() {
+		return Defuzzify(e.ConvertToT1FS(v.Default))
+	} else {
+		return e.ConvertToNumber()
+	}
+	return e.ConvertToNumber() / unit.ConvertToNumber()
+}
+
+func Distance(a, b Evaluated, variants v.Variants) (Number, error) {
+	if a.GetType() == (Interval{}).GetType() {
+		b = b.ConvertToNumber()
+	}
+
+	d, err := a.DiffNumber(b, variants)
+	if err != nil {
+		return 0, err
+	}
+
+	if variants == v.SqrtDistance {
+		return Number(math.Sqrt(float64(d))), nil
+	} else if variants == v.HammingDistance {
+		return d, nil
+	}
+	return Number(math.Cbrt(float64(d))), nil
+}
+
 func HighType(a, b string) string {
 	hasInterval := false
+	hasGrey := false
 	hasT1FS := false
 	hasAIFS := false
+	hasPFS := false
+	hasHFS := false
+	hasNS := false
 	hasIT2FS := false
 
 	types := []string{a, b}
@@ -206,12 +410,24 @@ func HighType(a, b string) string {
 		if t == (Interval{}).GetType() {
 			hasInterval = true
 		}
-		if t == (&T1FS{}).GetType() {
+		if t == (Grey{}).GetType() {
+			hasGrey = true
+		}
+		if t == (&T1FS{}).GetType() || t == (&ZNumber{}).GetType() {
 			hasT1FS = true
 		}
 		if t == (&AIFS{}).GetType() {
 			hasAIFS = true
 		}
+		if t == (&PFS{}).GetType() {
+			hasPFS = true
+		}
+		if t == (&HFS{}).GetType() {
+			hasHFS = true
+		}
+		if t == (&NS{}).GetType() {
+			hasNS = true
+		}
 		if t == (&IT2FS{}).GetType() {
 			hasIT2FS = true
 		}
@@ -220,12 +436,89 @@ func HighType(a, b string) string {
 	ret := NumbersMin.GetType()
 	if hasIT2FS {
 		ret = (&IT2FS{}).GetType()
+	} else if hasNS {
+		ret = (&NS{}).GetType()
+	} else if hasHFS {
+		ret = (&HFS{}).GetType()
+	} else if hasPFS {
+		ret = (&PFS{}).GetType()
 	} else if hasAIFS {
 		ret = (&AIFS{}).GetType()
 	} else if hasT1FS {
 		ret = (&T1FS{}).GetType()
+	} else if hasGrey {
+		ret = (Grey{}).GetType()
 	} else if hasInterval {
 		ret = (Interval{}).GetType()
 	}
 	return ret
 }
+
+func Product(a, b Evaluated) (Rating, error) {
+	if a.GetType() == NumbersMin.GetType() && b.GetType() == NumbersMin.GetType() {
+		return Rating{a.ConvertToNumber() * b.ConvertToNumber()}, nil
+	}
+
+	if a.GetType() == (&T1FS{}).GetType() || b.GetType() == (&T1FS{}).GetType() {
+		form := a.GetForm()
+		if a.GetType() != (&T1FS{}).GetType() {
+			form = b.GetForm()
+		}
+
+		at, bt := a.ConvertToT1FS(form).aligned(b.ConvertToT1FS(form))
+		if len(at.Vert) != len(bt.Vert) {
+			return Rating{}, v.IncompatibleTypes
+		}
+
+		vert := make([]Number, len(at.Vert))
+		for i := range vert {
+			vert[i] = at.Vert[i] * bt.Vert[i]
+		}
+		return Rating{at.reshaped(vert)}, nil
+	}
+
+	if a.GetType() == (Interval{}).GetType() || b.GetType() == (Interval{}).GetType() {
+		ai, bi := a.ConvertToInterval(), b.ConvertToInterval()
+		products := []float64{float64(ai.Start * bi.Start), float64(ai.Start * bi.End),
+			float64(ai.End * bi.Start), float64(ai.End * bi.End)}
+
+		s, f := products[0], products[0]
+		for _, p := range products {
+			s, f = math.Min(s, p), math.Max(f, p)
+		}
+		return Rating{Interval{Number(s), Number(f)}}, nil
+	}
+	return Rating{}, v.IncompatibleTypes
+}
+
+func Power(e Evaluated, exponent Number) (Rating, error) {
+	pow := func(x Number) Number {
+		return Number(math.Pow(math.Max(float64(x), 0), float64(exponent)))
+	}
+
+	if e.GetType() == NumbersMin.GetType() {
+		return Rating{pow(e.ConvertToNumber())}, nil
+	}
+
+	if e.GetType() == (Interval{}).GetType() {
+		s, f := pow(e.ConvertToInterval().Start), pow(e.ConvertToInterval().End)
+		if exponent < 0 {
+			s, f = f, s
+		}
+		return Rating{Interval{s, f}}, nil
+	}
+
+	if e.GetType() == (&T1FS{}).GetType() {
+		t := e.ConvertToT1FS(v.Default)
+		vert := make([]Number, len(t.Vert))
+		for i := range vert {
+			if exponent < 0 {
+				vert[i] = pow(t.Vert[len(vert)-i-1])
+			} else {
+				vert[i] = pow(t.Vert[i])
+			}
+		}
+		return Rating{t.reshaped(vert)}, nil
+	}
+	return Rating{}, v.IncompatibleTypes
+}
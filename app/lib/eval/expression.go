@@ -0,0 +1,145 @@
+package eval
+
+import (
+	"strings"
+	v "webApp/lib/variables"
+)
+
+// Hedges maps linguistic hedges to powers they raise membership to.
+var Hedges = map[string]Number{
+	"very":         2,
+	"extremely":    3,
+	"more or less": 0.5,
+	"somewhat":     0.5,
+}
+
+// Expression is a comparative linguistic expression turned into a hesitant
+// fuzzy linguistic term set, a range of consecutive terms of a scale, along
+// with the power of hedges applied to it.
+type Expression struct {
+	Text  string `json:"text"`
+	Lower int    `json:"lower"`
+	Upper int    `json:"upper"`
+	Power Number `json:"power"`
+}
+
+// ParseExpression parses expressions of the context-free grammar of
+// comparative linguistic expressions: a term, "at least", "at most",
+// "greater than" or "lower than" a term, "between" two terms, each possibly
+// preceded by hedges such as "very Good".
+func (l *LinguisticScale) ParseExpression(text string) (Expression, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	e := Expression{Text: text, Power: 1}
+	g := len(l.Marks) - 1
+
+	rest := text
+	for {
+		if i, ok := l.term(rest); ok {
+			e.Lower, e.Upper = i, i
+			return e, nil
+		}
+
+		hedged := false
+		for hedge, power := range Hedges {
+			if after, ok := cutKeyword(rest, hedge); ok {
+				rest, e.Power, hedged = after, e.Power*power, true
+				break
+			}
+		}
+
+		if !hedged {
+			break
+		}
+	}
+
+	var i int
+	var ok bool
+	if after, found := cutKeyword(rest, "at least"); found {
+		i, ok = l.term(after)
+		e.Lower, e.Upper = i, g
+	} else if after, found = cutKeyword(rest, "at most"); found {
+		i, ok = l.term(after)
+		e.Lower, e.Upper = 0, i
+	} else if after, found = cutKeyword(rest, "greater than"); found {
+		i, ok = l.term(after)
+		e.Lower, e.Upper = i+1, g
+	} else if after, found = cutKeyword(rest, "lower than"); found {
+		i, ok = l.term(after)
+		e.Lower, e.Upper = 0, i-1
+	} else if after, found = cutKeyword(rest, "between"); found {
+		ok = false
+		words := strings.Split(after, " and ")
+		for k := 1; k < len(words) && !ok; k++ {
+			first, okFirst := l.term(strings.Join(words[:k], " and "))
+			second, okSecond := l.term(strings.Join(words[k:], " and "))
+			e.Lower, e.Upper, ok = first, second, okFirst && okSecond && first <= second
+		}
+	}
+
+	if !ok || e.Lower > e.Upper || e.Lower < 0 || e.Upper > g {
+		return Expression{}, v.InvalidExpression
+	}
+	return e, nil
+}
+
+// term finds the index of a mark of the scale regardless of letter case.
+func (l *LinguisticScale) term(text string) (int, bool) {
+	for i := range l.Marks {
+		if strings.EqualFold(l.Marks[i], text) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func cutKeyword(text, keyword string) (string, bool) {
+	if len(text) > len(keyword) && strings.EqualFold(text[:len(keyword)], keyword) && text[len(keyword)] == ' ' {
+		return text[len(keyword)+1:], true
+	}
+	return text, false
+}
+
+// Envelope turns an expression into a rating covering its terms. On a scale
+// of type-1 fuzzy numbers it is the trapezoid from the lower term rising to
+// the core of the upper one, reshaped by hedges; on any other scale it is the
+// interval between bounds of the terms, which hedges leave unchanged.
+// A single term without hedges is the rating of the term itself.
+func (l *LinguisticScale) Envelope(e Expression) Rating {
+	lower, upper := l.Ratings[e.Lower], l.Ratings[e.Upper]
+	if lower.GetType() == (&T1FS{}).GetType() {
+		result := lower.ConvertToT1FS(v.Default)
+		if e.Lower != e.Upper {
+			a, b := lower.ConvertToT1FS(v.Trapezoid), upper.ConvertToT1FS(v.Trapezoid)
+			result = NewT1FS(a.Vert[0], a.Vert[1], b.Vert[2], b.Vert[3])
+		}
+
+		if e.Power != 1 {
+			result = result.Hedge(e.Power)
+		}
+		return Rating{result}
+	}
+
+	if e.Lower == e.Upper {
+		return lower.CopyEval()
+	}
+	return Rating{Interval{Start: lower.ConvertToInterval().Start, End: upper.ConvertToInterval().End}}
+}
+
+// Resolve rates a linguistic rating given by an expression, keeping the
+// expression as its mark. Ratings which are not linguistic, and those with
+// marks foreign to the scale but rated already, are returned as they are.
+func (l *LinguisticScale) Resolve(r Rating) (Rating, error) {
+	ling, ok := r.Evaluated.(Linguistic)
+	if !ok {
+		return r, nil
+	}
+
+	e, err := l.ParseExpression(ling.Mark)
+	if err != nil {
+		if !ling.Rating.IsNil() {
+			return r, nil
+		}
+		return Rating{}, err
+	}
+	return Rating{Linguistic{Mark: e.Text, Rating: l.Envelope(e)}}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"math"
+	v "webApp/lib/variables"
 )
 
 var (
@@ -35,6 +37,98 @@ var DefaultT1FSScale = &LinguisticScale{
 	Marks: []string{"Bad", "Normal", "Good", "Excellent"},
 }
 
+var DefaultNSScale = &LinguisticScale{
+	Ratings: []Rating{{NewNS(0.3, 0.65, 0.7)}, {NewNS(0.5, 0.5, 0.45)},
+		{NewNS(0.8, 0.15, 0.2)}, {NewNS(0.9, 0.1, 0.1)}},
+	Marks: []string{"Bad", "Normal", "Good", "Excellent"},
+}
+
+// Validate checks that every term of the scale has a rating, that ratings
+// are of one type and form and that terms strictly increase.
+func (l *LinguisticScale) Validate() error {
+	if len(l.Marks) < 2 || len(l.Ratings) != len(l.Marks) {
+		return errors.New("linguistic scale must rate each of at least two terms")
+	}
+
+	for i := range l.Ratings {
+		if l.Ratings[i].IsNil() {
+			return v.EmptyValues
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if l.Ratings[i].GetType() != l.Ratings[0].GetType() || l.Ratings[i].GetForm() != l.Ratings[0].GetForm() {
+			return v.IncompatibleTypes
+		}
+
+		if Defuzzify(l.Ratings[i]) <= Defuzzify(l.Ratings[i-1]) {
+			return errors.New("terms of linguistic scale must be monotone")
+		}
+	}
+	return nil
+}
+
+// Domain is the range of values covered by the scale, from the lower bound
+// of its first term to the upper bound of its last one.
+func (l *LinguisticScale) Domain() Grey {
+	return Grey{l.Ratings[0].ConvertToGrey().Lower, l.Ratings[len(l.Ratings)-1].ConvertToGrey().Upper}
+}
+
+// CheckScales validates scales of experts against the basic linguistic term
+// set, which all of them must share the domain with.
+func CheckScales(base *LinguisticScale, scales ...*LinguisticScale) error {
+	if err := base.Validate(); err != nil {
+		return err
+	}
+
+	domain := base.Domain()
+	for _, scale := range scales {
+		if err := scale.Validate(); err != nil {
+			return err
+		}
+
+		if d := scale.Domain(); !d.Lower.Equals(domain.Lower) || !d.Upper.Equals(domain.Upper) {
+			return errors.New("linguistic scales must cover the same domain")
+		}
+	}
+	return nil
+}
+
+// Transform carries a 2-tuple to a scale of another granularity with the
+// transformation function of linguistic hierarchies, Δ_to(Δ⁻¹(t)·g_to/g).
+func (l *LinguisticScale) Transform(t TwoTuple, to *LinguisticScale) (TwoTuple, error) {
+	g, err := l.granularity()
+	if err != nil {
+		return TwoTuple{}, err
+	}
+
+	gTo, err := to.granularity()
+	if err != nil {
+		return TwoTuple{}, err
+	}
+
+	if g == 0 {
+		return to.Delta(0)
+	}
+	return to.Delta(l.DeltaInverse(t) * Number(gTo) / Number(g))
+}
+
+// Rating is the rating of a 2-tuple: the rating of its term, or a fuzzy
+// interpolation between ratings of the two surrounding terms when it is
+// translated from the term.
+func (l *LinguisticScale) Rating(t TwoTuple) Rating {
+	beta := l.DeltaInverse(t)
+	i := int(math.Floor(float64(beta)))
+	frac := beta - Number(i)
+	if i >= len(l.Ratings)-1 || frac < 1e-9 {
+		i = min(max(i, 0), len(l.Ratings)-1)
+		return Rating{Linguistic{Mark: l.Marks[i], Rating: l.Ratings[i].CopyEval()}}
+	}
+	return l.Ratings[i].Weighted(1 - frac).Sum(l.Ratings[i+1].Weighted(frac))
+}
+
 func (l LinguisticScale) Value() (driver.Value, error) {
 	data, err := json.Marshal(l)
 	return string(data), err
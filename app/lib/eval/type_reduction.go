@@ -0,0 +1,127 @@
+package eval
+
+import (
+	"math"
+	v "webApp/lib/variables"
+)
+
+// Centroid is the type-reduced set [c_l, c_r] of centroids of all embedded
+// type-1 sets, found with enhanced Karnik–Mendel algorithm.
+func (t *IT2FS) Centroid() Interval {
+	return t.TypeReduction(v.EnhancedKarnikMendel)
+}
+
+// TypeReduction finds the centroid interval with Karnik–Mendel algorithm or
+// its enhanced version over the support sampled at CountOfAlfaSlices points.
+// Both converge to the same ends, the enhanced one in fewer sums.
+func (t *IT2FS) TypeReduction(algorithm v.Variants) Interval {
+	x, lower, upper := t.sampled()
+	if len(x) == 0 {
+		return Interval{t.Upward[0], t.Upward[0]}
+	}
+
+	if algorithm == v.EnhancedKarnikMendel {
+		return Interval{enhancedKarnikMendel(x, lower, upper, true), enhancedKarnikMendel(x, lower, upper, false)}
+	}
+	return Interval{karnikMendel(x, lower, upper, true), karnikMendel(x, lower, upper, false)}
+}
+
+// sampled returns points of the support with degrees of lower and upper
+// membership functions at them, or nothing for a crisp set.
+func (t *IT2FS) sampled() ([]Number, []Number, []Number) {
+	u, l := t.Upper(), t.Lower()
+	support := u.MemberFunction(0)
+	if support.End-support.Start < 1e-9 {
+		return nil, nil, nil
+	}
+
+	x := make([]Number, CountOfAlfaSlices+1)
+	lower, upper := make([]Number, len(x)), make([]Number, len(x))
+	for i := range x {
+		x[i] = support.Start + (support.End-support.Start)*Number(i)/Number(CountOfAlfaSlices)
+		lower[i], upper[i] = l.Degree(x[i]), u.Degree(x[i])
+	}
+	return x, lower, upper
+}
+
+// karnikMendel finds the left end of the centroid, which weights points left
+// of the switch point with upper degrees and the rest with lower ones, or the
+// right end with the opposite choice, moving the switch point until it stays.
+func karnikMendel(x, lower, upper []Number, left bool) Number {
+	num, den := Number(0), Number(0)
+	for i := range x {
+		num += x[i] * (lower[i] + upper[i]) / 2
+		den += (lower[i] + upper[i]) / 2
+	}
+	c := num / den
+
+	for iter := 0; iter < len(x); iter++ {
+		num, den = 0, 0
+		for i := range x {
+			mu := lower[i]
+			if (x[i] <= c) == left {
+				mu = upper[i]
+			}
+			num += x[i] * mu
+			den += mu
+		}
+
+		next := num / den
+		if math.Abs(float64(next-c)) < 1e-9 {
+			return next
+		}
+		c = next
+	}
+	return c
+}
+
+// enhancedKarnikMendel is the algorithm of Wu and Mendel: it starts from
+// the switch point at N/2.4 for the left end or N/1.7 for the right one and
+// updates sums only by the points the switch point has passed.
+func enhancedKarnikMendel(x, lower, upper []Number, left bool) Number {
+	start := 1.7
+	if left {
+		start = 2.4
+	}
+	k := int(math.Round(float64(len(x)) / start))
+
+	// the first k points take upper degrees for the left end, lower ones
+	// for the right end, so diff is the change of a point moved to them
+	diff := make([]Number, len(x))
+	num, den := Number(0), Number(0)
+	for i := range x {
+		diff[i] = upper[i] - lower[i]
+		mu := upper[i]
+		if (i < k) != left {
+			mu = lower[i]
+		}
+		if !left {
+			diff[i] = -diff[i]
+		}
+		num += x[i] * mu
+		den += mu
+	}
+
+	for iter := 0; iter < len(x) && den > 0; iter++ {
+		c := num / den
+		next := 0
+		for next < len(x) && x[next] <= c {
+			next++
+		}
+
+		if next == k {
+			return c
+		}
+
+		low, high, sign := k, next, Number(1)
+		if next < k {
+			low, high, sign = next, k, -1
+		}
+		for i := low; i < high; i++ {
+			num += sign * x[i] * diff[i]
+			den += sign * diff[i]
+		}
+		k = next
+	}
+	return num / den
+}
@@ -8,11 +8,21 @@ import (
 	"runtime"
 	"sync"
 	"time"
+	"webApp/lib/aras"
+	"webApp/lib/codas"
+	"webApp/lib/edas"
+	"webApp/lib/electre"
 	"webApp/lib/eval"
+	"webApp/lib/gra"
+	"webApp/lib/mabac"
 	"webApp/lib/matrix"
+	"webApp/lib/multimoora"
+	"webApp/lib/promethee"
 	"webApp/lib/smart"
+	"webApp/lib/todim"
 	"webApp/lib/topsis"
 	v "webApp/lib/variables"
+	"webApp/lib/vikor"
 )
 
 type SensitivityResult struct {
@@ -43,19 +53,35 @@ func (s *SensitivityResult) Scan(src interface{}) error {
 	return nil
 }
 
+// CalcSettings is stored packed by Comprise into the calc_settings column of
+// a task, four bits per variant. All sixteen nibbles are taken, so a new
+// setting gets its own task column, as Theta and OptimisticHesitance do.
 type CalcSettings struct {
-	ValueNorm   v.Variants
-	WeighNorm   v.Variants
-	RankingAlg  v.Variants
-	FsDist      v.Variants
-	IntDist     v.Variants
-	NumDist     v.Variants
-	Aggregating v.Variants
+	ValueNorm           v.Variants
+	WeighNorm           v.Variants
+	RankingAlg          v.Variants
+	FsDist              v.Variants
+	IntDist             v.Variants
+	NumDist             v.Variants
+	Aggregating         v.Variants
+	WeightSource        v.Variants
+	WeightMixing        v.Variants
+	MixCoefficient      v.Variants
+	Scoring             v.Variants
+	WaspasLambda        v.Variants
+	Fusion              v.Variants
+	CodasThreshold      v.Variants
+	Rho                 v.Variants
+	Defuzzification     v.Variants
+	Theta               float64
+	OptimisticHesitance bool
 }
 
 func (c *CalcSettings) Comprise() int64 {
 	result := c.ValueNorm | (c.WeighNorm << 4) | (c.RankingAlg << 8) | (c.FsDist << 12) |
-		(c.IntDist << 16) | (c.NumDist << 20) | (c.Aggregating << 24)
+		(c.IntDist << 16) | (c.NumDist << 20) | (c.Aggregating << 24) | (c.WeightSource << 28) |
+		(c.WeightMixing << 32) | (c.MixCoefficient << 36) | (c.Scoring << 40) | (c.WaspasLambda << 44) |
+		(c.Fusion << 48) | (c.CodasThreshold << 52) | (c.Rho << 56) | (c.Defuzzification << 60)
 	return int64(result)
 }
 
@@ -67,16 +93,141 @@ func (c *CalcSettings) Parse(settings int64) {
 	c.IntDist = v.Variants((settings >> 16) & 0b1111)
 	c.NumDist = v.Variants((settings >> 20) & 0b1111)
 	c.Aggregating = v.Variants((settings >> 24) & 0b1111)
+	c.WeightSource = v.Variants((settings >> 28) & 0b1111)
+	c.WeightMixing = v.Variants((settings >> 32) & 0b1111)
+	c.MixCoefficient = v.Variants((settings >> 36) & 0b1111)
+	c.Scoring = v.Variants((settings >> 40) & 0b1111)
+	c.WaspasLambda = v.Variants((settings >> 44) & 0b1111)
+	c.Fusion = v.Variants((settings >> 48) & 0b1111)
+	c.CodasThreshold = v.Variants((settings >> 52) & 0b1111)
+	c.Rho = v.Variants((settings >> 56) & 0b1111)
+	c.Defuzzification = v.Variants((settings >> 60) & 0b1111)
 }
 
-func SensAnalysis(method v.Method, calcSettings int64, threshold float64, mxs []matrix.Matrix, w []eval.Rating) (*SensitivityResult, error) {
+func (c *CalcSettings) waspasLambda() float64 {
+	if c.Scoring == v.ProductScore {
+		return 0
+	} else if c.WaspasLambda == 0 {
+		return smart.DefaultWaspasLambda
+	}
+	return float64(c.WaspasLambda) / 10
+}
+
+func (c *CalcSettings) codasThreshold() float64 {
+	if c.CodasThreshold == 0 {
+		return codas.DefaultThreshold
+	}
+	return float64(c.CodasThreshold) / 100
+}
+
+func (c *CalcSettings) rho() float64 {
+	if c.Rho == 0 {
+		return gra.DefaultRho
+	}
+	return float64(c.Rho) / 10
+}
+
+func (c *CalcSettings) theta() float64 {
+	if c.Theta == 0 {
+		return todim.DefaultTheta
+	}
+	return c.Theta
+}
+
+// applyDefuzzification makes rankings and distances between crisp values
+// use the chosen defuzzification method, which aggregation carries over.
+func (c *CalcSettings) applyDefuzzification(mxs []matrix.Matrix) {
+	for i := range mxs {
+		mxs[i].Defuzzification = c.Defuzzification
+	}
+}
+
+// applyHesitance marks hesitant ratings with the extension rule of the task,
+// which the values computed from them inherit.
+func (c *CalcSettings) applyHesitance(mxs []matrix.Matrix) {
+	for i := range mxs {
+		mxs[i].SetHesitance(c.OptimisticHesitance)
+	}
+}
+
+func (c *CalcSettings) applyWeightSource(m *matrix.Matrix) error {
+	return m.SetObjectiveWeights(c.WeightSource, c.WeightMixing, eval.Number(c.MixCoefficient)/10)
+}
+
+// Final is the outcome of a method: the ranking of alternatives and the
+// details which only some of the methods give.
+type Final struct {
+	Result       matrix.RankedList
+	Acceptance   *vikor.Acceptance
+	PartialOrder *matrix.PartialOrder
+	SubRankings  *matrix.RankedLists
+}
+
+type calculator func(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error)
+
+// ranking adapts a method giving only the ranked list to a calculator.
+func ranking(calc func(CalcSettings, []matrix.Matrix, []eval.Evaluated) (matrix.RankedList, error)) calculator {
+	return func(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error) {
+		list, err := calc(settings, mxs, weights)
+		if err != nil {
+			return nil, err
+		}
+		return &Final{Result: list}, nil
+	}
+}
+
+// ordering adapts a method giving a partial order along with the ranked list.
+func ordering(calc func(CalcSettings, []matrix.Matrix, []eval.Evaluated) (matrix.RankedList, matrix.PartialOrder, error)) calculator {
+	return func(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error) {
+		list, order, err := calc(settings, mxs, weights)
+		if err != nil {
+			return nil, err
+		}
+		return &Final{Result: list, PartialOrder: &order}, nil
+	}
+}
+
+var calculators = map[v.Method]calculator{
+	v.TOPSIS: ranking(TopsisFullCalc),
+	v.SMART:  ranking(SmartFullCalc),
+	v.VIKOR: func(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error) {
+		list, acceptance, err := VikorFullCalc(settings, mxs, weights)
+		if err != nil {
+			return nil, err
+		}
+		return &Final{Result: list, Acceptance: &acceptance}, nil
+	},
+	v.PROMETHEE: ordering(PrometheeFullCalc),
+	v.ELECTRE:   ordering(ElectreFullCalc),
+	v.MULTIMOORA: func(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error) {
+		list, subRankings, err := MultimooraFullCalc(settings, mxs, weights)
+		if err != nil {
+			return nil, err
+		}
+		return &Final{Result: list, SubRankings: &subRankings}, nil
+	},
+	v.EDAS:  ranking(EdasFullCalc),
+	v.CODAS: ranking(CodasFullCalc),
+	v.MABAC: ranking(MabacFullCalc),
+	v.ARAS:  ranking(ArasFullCalc),
+	v.TODIM: ranking(TodimFullCalc),
+	v.GRA:   ranking(GraFullCalc),
+}
+
+// FullCalc runs the full calculation of the method on ratings of experts.
+func FullCalc(method v.Method, settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (*Final, error) {
+	calc, ok := calculators[method]
+	if !ok {
+		return nil, errors.New("invalid method")
+	}
+	return calc(settings, mxs, weights)
+}
+
+func SensAnalysis(method v.Method, settings CalcSettings, threshold float64, mxs []matrix.Matrix, w []eval.Rating) (*SensitivityResult, error) {
 	if len(mxs) != len(w) {
 		return nil, v.InvalidSize
 	}
 
-	settings := CalcSettings{}
-	settings.Parse(calcSettings)
-
 	result := SensitivityResult{Results: make([]matrix.RankedList, 10), Threshold: threshold}
 	gen := rand.New(rand.NewSource(time.Now().Unix()))
 	var err error
@@ -93,23 +244,12 @@ func SensAnalysis(method v.Method, calcSettings int64, threshold float64, mxs []
 				changeMatrices[i] = randomChange(&mxs[i], threshold, gen)
 			}
 
-			var inerr error
-			if method == v.TOPSIS {
-				result.Results[i], inerr = TopsisFullCalc(settings, changeMatrices, weights)
-				if inerr != nil {
-					err = inerr
-					return
-				}
-			} else if method == v.SMART {
-				result.Results[i], inerr = SmartFullCalc(settings, changeMatrices, weights)
-				if inerr != nil {
-					err = inerr
-					return
-				}
-			} else {
-				err = errors.New("invalid method")
+			final, inerr := FullCalc(method, settings, changeMatrices, weights)
+			if inerr != nil {
+				err = inerr
 				return
 			}
+			result.Results[i] = final.Result
 		}(i)
 	}
 	wg.Wait()
@@ -136,35 +276,28 @@ func randomChange(m *matrix.Matrix, threshold float64, gen *rand.Rand) matrix.Ma
 	return *newMatrix
 }
 
-func TopsisFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+// calculate runs calc either on the matrix aggregated from all experts or on
+// every expert matrix concurrently, merging the latter with aggregate. All
+// methods share it, so ratings are typed and split between goroutines the
+// same way whatever the method.
+func calculate[T any](settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated,
+	calc func(m *matrix.Matrix, g int) (*T, error), aggregate func([]T, []eval.Evaluated) (*T, error)) (*T, error) {
 	var err error
 	var g = runtime.NumCPU()
+	settings.applyDefuzzification(mxs)
+	if err = matrix.TypingMatrices(g, mxs...); err != nil {
+		return nil, err
+	}
+	settings.applyHesitance(mxs)
 
 	if settings.Aggregating == v.AggregateMatrix {
 		aggMatrix, err := matrix.AggregateRatings(mxs, weights, g)
 		if err != nil {
-			return matrix.RankedList{}, err
-		}
-
-		resultMatrix := topsis.ConvertToTopsisMatrix(aggMatrix)
-		if err = resultMatrix.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
-			return matrix.RankedList{}, err
-		}
-
-		resultMatrix.CalcWeightedMatrix(g)
-
-		if err = resultMatrix.FindIdeals(settings.RankingAlg, g); err != nil {
-			return matrix.RankedList{}, err
-		}
-
-		if err = resultMatrix.FindDistanceToIdeals(settings.FsDist, settings.IntDist, settings.NumDist, g); err != nil {
-			return matrix.RankedList{}, err
+			return nil, err
 		}
-
-		resultMatrix.CalcCloseness(g)
-		return resultMatrix.RankedList(settings.RankingAlg), nil
+		return calc(aggMatrix, g)
 	} else if settings.Aggregating == v.AggregateFinals {
-		matrices := make([]topsis.TopsisMatrix, len(mxs))
+		matrices := make([]T, len(mxs))
 
 		var wg sync.WaitGroup
 		var averG int
@@ -184,121 +317,347 @@ func TopsisFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.E
 
 				start := (len(mxs) / batches) * b
 				end := start + (len(mxs) / batches)
+				share := averG
 				if b == batches-1 {
 					end = len(mxs)
-					averG = g - averG*(batches-1)
+					share = g - averG*(batches-1)
 				}
 
 				for i := start; i < end; i++ {
-					matrices[i] = *topsis.ConvertToTopsisMatrix(&mxs[i])
-					if inerr := matrices[i].Normalization(settings.ValueNorm, settings.WeighNorm, averG); inerr != nil {
-						err = inerr
-						return
-					}
-
-					matrices[i].CalcWeightedMatrix(averG)
-
-					if inerr := matrices[i].FindIdeals(settings.RankingAlg, averG); inerr != nil {
-						err = inerr
-						return
-					}
-
-					if inerr := matrices[i].FindDistanceToIdeals(settings.FsDist, settings.IntDist, settings.NumDist, averG); inerr != nil {
+					result, inerr := calc(&mxs[i], share)
+					if inerr != nil {
 						err = inerr
 						return
 					}
+					matrices[i] = *result
 				}
 			}(b)
 		}
 		wg.Wait()
 		if err != nil {
-			return matrix.RankedList{}, err
+			return nil, err
 		}
 
-		aggMatrix, err := topsis.AggregateDistances(matrices, weights)
-		if err != nil {
-			return matrix.RankedList{}, err
+		return aggregate(matrices, weights)
+	} else {
+		return nil, v.InvalidCaseOfOperation
+	}
+}
+
+func TopsisFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*topsis.TopsisMatrix, error) {
+		tm := topsis.ConvertToTopsisMatrix(m)
+		if err := settings.applyWeightSource(tm.Matrix); err != nil {
+			return nil, err
 		}
 
-		aggMatrix.CalcCloseness(g)
-		return aggMatrix.RankedList(settings.RankingAlg), nil
-	} else {
-		return matrix.RankedList{}, v.InvalidCaseOfOperation
+		if err := tm.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
+			return nil, err
+		}
+
+		tm.CalcWeightedMatrix(g)
+
+		if err := tm.FindIdeals(settings.RankingAlg, g); err != nil {
+			return nil, err
+		}
+
+		if err := tm.FindDistanceToIdeals(settings.FsDist, settings.IntDist, settings.NumDist, g); err != nil {
+			return nil, err
+		}
+		return tm, nil
+	}, topsis.AggregateDistances)
+	if err != nil {
+		return matrix.RankedList{}, err
 	}
+
+	resultMatrix.CalcCloseness(runtime.NumCPU())
+	return resultMatrix.RankedList(settings.RankingAlg), nil
 }
 
 func SmartFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
-	var err error
-	var g = runtime.NumCPU()
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*smart.SmartMatrix, error) {
+		sm := smart.ConvertToSmartMatrix(m)
+		if err := settings.applyWeightSource(sm.Matrix); err != nil {
+			return nil, err
+		}
 
-	if settings.Aggregating == v.AggregateMatrix {
-		aggMatrix, err := matrix.AggregateRatings(mxs, weights, g)
-		if err != nil {
-			return matrix.RankedList{}, err
+		raw := matrix.CopyMatrix(sm.Matrix)
+		if err := sm.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
+			return nil, err
+		}
+
+		if settings.Scoring != v.AdditiveScore {
+			if err := sm.CalcProductScore(raw, g); err != nil {
+				return nil, err
+			}
 		}
 
-		resultMatrix := smart.ConvertToSmartMatrix(aggMatrix)
+		sm.CalcWeightedMatrix(g)
+
+		sm.CalcFinalScore(g)
 
-		if err := resultMatrix.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
-			return matrix.RankedList{}, err
+		if settings.Scoring != v.AdditiveScore {
+			sm.CalcWaspasScore(settings.waspasLambda())
 		}
+		return sm, nil
+	}, smart.AggregateScores)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
 
-		resultMatrix.CalcWeightedMatrix(g)
+	return resultMatrix.RankedList(settings.RankingAlg), nil
+}
 
-		resultMatrix.CalcFinalScore(g)
+func VikorFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, vikor.Acceptance, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*vikor.VikorMatrix, error) {
+		vm := vikor.ConvertToVikorMatrix(m)
+		if err := settings.applyWeightSource(vm.Matrix); err != nil {
+			return nil, err
+		}
 
-		return resultMatrix.RankedList(settings.RankingAlg), nil
-	} else if settings.Aggregating == v.AggregateFinals {
-		matrices := make([]smart.SmartMatrix, len(mxs))
+		if err := vm.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
 
-		var wg sync.WaitGroup
-		var averG int
-		var batches int
-		if g > len(mxs) {
-			averG = g / len(mxs)
-			batches = len(mxs)
-		} else {
-			averG = 1
-			batches = g
+		if err := vm.FindIdeals(g); err != nil {
+			return nil, err
 		}
 
-		wg.Add(batches)
-		for b := 0; b < batches; b++ {
-			go func(b int) {
-				defer wg.Done()
+		if err := vm.CalcIndexes(g); err != nil {
+			return nil, err
+		}
+		return vm, nil
+	}, vikor.AggregateIndexes)
+	if err != nil {
+		return matrix.RankedList{}, vikor.Acceptance{}, err
+	}
 
-				start := (len(mxs) / batches) * b
-				end := start + (len(mxs) / batches)
-				if b == batches-1 {
-					end = len(mxs)
-					averG = g - averG*(batches-1)
-				}
+	if err = resultMatrix.CalcCompromise(vikor.DefaultStrategyWeight); err != nil {
+		return matrix.RankedList{}, vikor.Acceptance{}, err
+	}
+	return resultMatrix.RankedList(settings.RankingAlg), resultMatrix.CheckAcceptance(), nil
+}
 
-				for i := start; i < end; i++ {
-					matrices[i] = *smart.ConvertToSmartMatrix(&mxs[i])
-					if inerr := matrices[i].Normalization(settings.ValueNorm, settings.WeighNorm, averG); inerr != nil {
-						err = inerr
-						return
-					}
+func PrometheeFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, matrix.PartialOrder, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*promethee.PrometheeMatrix, error) {
+		pm := promethee.ConvertToPrometheeMatrix(m)
+		if err := settings.applyWeightSource(pm.Matrix); err != nil {
+			return nil, err
+		}
 
-					matrices[i].CalcWeightedMatrix(averG)
+		if err := pm.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
 
-					matrices[i].CalcFinalScore(averG)
-				}
-			}(b)
+		pm.CalcPreferences(g)
+
+		pm.CalcFlows()
+		return pm, nil
+	}, promethee.AggregateFlows)
+	if err != nil {
+		return matrix.RankedList{}, matrix.PartialOrder{}, err
+	}
+
+	return resultMatrix.RankedList(settings.RankingAlg), resultMatrix.PartialOrder(), nil
+}
+
+func ElectreFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, matrix.PartialOrder, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*electre.ElectreMatrix, error) {
+		em := electre.ConvertToElectreMatrix(m)
+		if err := settings.applyWeightSource(em.Matrix); err != nil {
+			return nil, err
 		}
-		wg.Wait()
-		if err != nil {
-			return matrix.RankedList{}, err
+
+		if err := em.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
 		}
 
-		result, err := smart.AggregateScores(matrices, weights)
-		if err != nil {
-			return matrix.RankedList{}, err
+		em.CalcCredibility(g)
+		return em, nil
+	}, electre.AggregateCredibility)
+	if err != nil {
+		return matrix.RankedList{}, matrix.PartialOrder{}, err
+	}
+
+	resultMatrix.Distillation()
+	return resultMatrix.RankedList(settings.RankingAlg), resultMatrix.PreOrder(), nil
+}
+
+func MultimooraFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, matrix.RankedLists, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*multimoora.MultimooraMatrix, error) {
+		mm := multimoora.ConvertToMultimooraMatrix(m)
+		if err := settings.applyWeightSource(mm.Matrix); err != nil {
+			return nil, err
 		}
 
-		return result.RankedList(settings.RankingAlg), nil
-	} else {
-		return matrix.RankedList{}, v.InvalidCaseOfOperation
+		if err := mm.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
+
+		mm.VectorNormalization()
+		mm.CalcIndexes(g)
+		return mm, nil
+	}, multimoora.AggregateIndexes)
+	if err != nil {
+		return matrix.RankedList{}, nil, err
 	}
+
+	return resultMatrix.RankedList(settings.Fusion), resultMatrix.SubRankings(), nil
+}
+
+func EdasFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*edas.EdasMatrix, error) {
+		em := edas.ConvertToEdasMatrix(m)
+		if err := settings.applyWeightSource(em.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := em.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
+
+		em.FindAverage()
+
+		if err := em.FindDistancesToAverage(settings.NumDist, g); err != nil {
+			return nil, err
+		}
+		return em, nil
+	}, edas.AggregateDistances)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	resultMatrix.CalcAppraisalScores()
+	return resultMatrix.RankedList(), nil
+}
+
+func CodasFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*codas.CodasMatrix, error) {
+		cm := codas.ConvertToCodasMatrix(m)
+		if err := settings.applyWeightSource(cm.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := cm.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
+			return nil, err
+		}
+
+		cm.CalcWeightedMatrix(g)
+
+		cm.FindNegativeIdeal()
+
+		if err := cm.FindDistances(settings.NumDist, g); err != nil {
+			return nil, err
+		}
+		return cm, nil
+	}, codas.AggregateDistances)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	resultMatrix.CalcAssessment(settings.codasThreshold())
+	return resultMatrix.RankedList(), nil
+}
+
+func MabacFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*mabac.MabacMatrix, error) {
+		mm := mabac.ConvertToMabacMatrix(m)
+		if err := settings.applyWeightSource(mm.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := mm.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
+
+		mm.MinMaxNormalization()
+
+		mm.FindBorderArea()
+
+		mm.CalcDistances(g)
+		return mm, nil
+	}, mabac.AggregateDistances)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	return resultMatrix.RankedList(), nil
+}
+
+func ArasFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*aras.ArasMatrix, error) {
+		am := aras.ConvertToArasMatrix(m)
+		if err := settings.applyWeightSource(am.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := am.NormalizationWeights(settings.WeighNorm); err != nil {
+			return nil, err
+		}
+
+		if err := am.FindOptimal(); err != nil {
+			return nil, err
+		}
+
+		if err := am.CalcUtilities(g); err != nil {
+			return nil, err
+		}
+		return am, nil
+	}, aras.AggregateUtilities)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	return resultMatrix.RankedList(), nil
+}
+
+func TodimFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*todim.TodimMatrix, error) {
+		tm := todim.ConvertToTodimMatrix(m)
+		if err := settings.applyWeightSource(tm.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := tm.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
+			return nil, err
+		}
+
+		if err := tm.CalcDominance(settings.ValueNorm, settings.theta(), settings.NumDist, g); err != nil {
+			return nil, err
+		}
+		return tm, nil
+	}, todim.AggregateDominance)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	resultMatrix.CalcOverall()
+	return resultMatrix.RankedList(), nil
+}
+
+func GraFullCalc(settings CalcSettings, mxs []matrix.Matrix, weights []eval.Evaluated) (matrix.RankedList, error) {
+	resultMatrix, err := calculate(settings, mxs, weights, func(m *matrix.Matrix, g int) (*gra.GraMatrix, error) {
+		gm := gra.ConvertToGraMatrix(m)
+		if err := settings.applyWeightSource(gm.Matrix); err != nil {
+			return nil, err
+		}
+
+		if err := gm.Normalization(settings.ValueNorm, settings.WeighNorm, g); err != nil {
+			return nil, err
+		}
+
+		gm.FindReference(settings.ValueNorm)
+
+		if err := gm.CalcCoefficients(settings.rho(), settings.NumDist, g); err != nil {
+			return nil, err
+		}
+
+		gm.CalcGrades()
+		return gm, nil
+	}, gra.AggregateGrades)
+	if err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	return resultMatrix.RankedList(), nil
 }
@@ -0,0 +1,125 @@
+package gra
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const DefaultRho = 0.5
+
+func greyDistance(a, b eval.Evaluated, vn v.Variants) (eval.Number, error) {
+	if a.GetType() == (eval.Interval{}).GetType() && b.GetType() == (eval.Interval{}).GetType() {
+		return eval.Number(math.Max(math.Abs(float64(a.ConvertToInterval().Start-b.ConvertToInterval().Start)),
+			math.Abs(float64(a.ConvertToInterval().End-b.ConvertToInterval().End)))), nil
+	}
+	return eval.Distance(a, b, vn)
+}
+
+func (gm *GraMatrix) FindReference(valueNorm v.Variants) {
+	gm.Reference = matrix.Alternative{Grade: make([]eval.Rating, gm.CountCriteria), CountOfCriteria: gm.CountCriteria}
+	for j, c := range gm.Criteria {
+		reference := gm.Data[0].Grade[j].CopyEval()
+		for i := 1; i < gm.CountAlternatives; i++ {
+			if valueNorm == v.NormalizeWithSum && c.TypeOfCriteria == v.Cost {
+				reference = eval.Min(reference.Evaluated, gm.Data[i].Grade[j].Evaluated)
+			} else {
+				reference = eval.Max(reference.Evaluated, gm.Data[i].Grade[j].Evaluated)
+			}
+		}
+		gm.Reference.Grade[j] = reference
+	}
+	gm.ReferenceFind = true
+}
+
+func (gm *GraMatrix) CalcCoefficients(rho float64, vn v.Variants, g int) error {
+	var wg sync.WaitGroup
+	var err error
+
+	if rho <= 0 || rho > 1 {
+		return v.InvalidCaseOfOperation
+	}
+
+	if g > gm.CountAlternatives {
+		g = gm.CountAlternatives
+	}
+	off := gm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = gm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				for j := 0; j < gm.CountCriteria; j++ {
+					d, inerr := greyDistance(gm.Data[i].Grade[j].Evaluated, gm.Reference.Grade[j].Evaluated, vn)
+					if inerr != nil {
+						err = inerr
+						return
+					}
+					gm.Coefficients[i][j] = d
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	minimum, maximum := eval.NumbersMax, eval.Number(0)
+	for i := range gm.Coefficients {
+		for j := range gm.Coefficients[i] {
+			minimum = min(minimum, gm.Coefficients[i][j])
+			maximum = max(maximum, gm.Coefficients[i][j])
+		}
+	}
+
+	for i := range gm.Coefficients {
+		for j := range gm.Coefficients[i] {
+			if maximum == 0 {
+				gm.Coefficients[i][j] = 1
+			} else {
+				gm.Coefficients[i][j] = (minimum + eval.Number(rho)*maximum) / (gm.Coefficients[i][j] + eval.Number(rho)*maximum)
+			}
+		}
+	}
+	gm.CoefficientsFind = true
+	return nil
+}
+
+func (gm *GraMatrix) CalcGrades() {
+	for i := range gm.Coefficients {
+		grade := eval.Number(0)
+		for j, c := range gm.Criteria {
+			grade += eval.Crisp(c.Weight, gm.Defuzzification) * gm.Coefficients[i][j]
+		}
+		gm.Grades[i] = eval.Rating{Evaluated: grade}
+	}
+	gm.GradesFind = true
+}
+
+func (gm *GraMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(gm.Grades))
+	ind := make([]int, len(gm.Grades))
+	for i := range set {
+		ind[i] = i
+		set[i] = gm.Grades[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], gm.Defuzzification) > eval.Crisp(set[ind[j]], gm.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], gm.Defuzzification) > eval.Crisp(set[j], gm.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
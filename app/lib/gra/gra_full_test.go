@@ -0,0 +1,89 @@
+package gra
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func GraCalculating(graMatrix *GraMatrix, valueNorm, weightNorm v.Variants, rho float64) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(1, *graMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	if err := graMatrix.Normalization(valueNorm, weightNorm, 5); err != nil {
+		return nil, err
+	}
+
+	graMatrix.FindReference(valueNorm)
+
+	if err := graMatrix.CalcCoefficients(rho, v.SqrtDistance, 5); err != nil {
+		return nil, err
+	}
+
+	graMatrix.CalcGrades()
+
+	return graMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *GraMatrix
+		valueNorm  v.Variants
+		weightNorm v.Variants
+		rho        float64
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToGraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			rho:        DefaultRho,
+			resultRow:  []eval.Number{0.774, 0.715, 0.443, 0.527},
+		},
+		{
+			initMat:    ConvertToGraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			rho:        0.3,
+			resultRow:  []eval.Number{0.711, 0.645, 0.325, 0.449},
+		},
+		{
+			initMat:    ConvertToGraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			rho:        DefaultRho,
+			resultRow:  []eval.Number{0.679, 0.776, 0.617},
+		},
+		{
+			initMat:    ConvertToGraMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			rho:        DefaultRho,
+			resultRow:  []eval.Number{0.392, 0.432, 0.711, 0.676},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := GraCalculating(tt.initMat, tt.valueNorm, tt.weightNorm, tt.rho); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
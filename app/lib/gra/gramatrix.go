@@ -0,0 +1,103 @@
+package gra
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type GraMatrix struct {
+	*matrix.Matrix
+	Reference        matrix.Alternative `json:"reference"`
+	ReferenceFind    bool               `json:"is_reference_find"`
+	Coefficients     [][]eval.Number    `json:"coefficients"`
+	CoefficientsFind bool               `json:"is_coefficients_find"`
+	Grades           []eval.Rating      `json:"grades"`
+	GradesFind       bool               `json:"is_grades_find"`
+}
+
+func NewGraMatrix(x, y int) *GraMatrix {
+	return &GraMatrix{
+		Matrix:       matrix.NewMatrix(x, y),
+		Coefficients: newCoefficients(x, y),
+		Grades:       make([]eval.Rating, x),
+	}
+}
+
+func ConvertToGraMatrix(m *matrix.Matrix) *GraMatrix {
+	return &GraMatrix{
+		Matrix:       matrix.CopyMatrix(m),
+		Coefficients: newCoefficients(m.CountAlternatives, m.CountCriteria),
+		Grades:       make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func newCoefficients(x, y int) [][]eval.Number {
+	coefficients := make([][]eval.Number, x)
+	for i := range coefficients {
+		coefficients[i] = make([]eval.Number, y)
+	}
+	return coefficients
+}
+
+func (gm *GraMatrix) GetCoefs() []eval.Rating {
+	return gm.Grades
+}
+
+func (gm *GraMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range gm.Data {
+		s += gm.Data[i].String() + "\n"
+	}
+
+	if gm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < gm.CountCriteria; i++ {
+			s += gm.Criteria[i].String() + " "
+		}
+	}
+
+	if gm.ReferenceFind {
+		s += "\nReference sequence:\n" + gm.Reference.String()
+	}
+
+	if gm.CoefficientsFind {
+		s += "\nGrey relational coefficients:\n"
+		for i := range gm.Coefficients {
+			for j := range gm.Coefficients[i] {
+				s += gm.Coefficients[i][j].String() + " "
+			}
+			s += "\n"
+		}
+	}
+
+	if gm.GradesFind {
+		s += "\nGrey relational grades:\n"
+		for i := 0; i < gm.CountAlternatives; i++ {
+			s += gm.Grades[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateGrades(matrices []GraMatrix, weights []eval.Evaluated) (*GraMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewGraMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i, grade := range matrices[k].Grades {
+			if k == 0 {
+				result.Grades[i] = grade.Weighted(weights[k])
+				continue
+			}
+			result.Grades[i] = result.Grades[i].Sum(grade.Weighted(weights[k]))
+		}
+	}
+	result.GradesFind = true
+	return result, nil
+}
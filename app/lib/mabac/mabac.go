@@ -0,0 +1,74 @@
+package mabac
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+)
+
+func (mm *MabacMatrix) MinMaxNormalization() {
+	mm.Normalized = mm.LinearNormalization()
+	mm.NormalizedFind = true
+}
+
+func (mm *MabacMatrix) FindBorderArea() {
+	for j, c := range mm.Criteria {
+		weight := eval.Crisp(c.Weight, mm.Defuzzification)
+		product := 1.
+		for i := range mm.Normalized {
+			mm.Normalized[i][j] = weight * (mm.Normalized[i][j] + 1)
+			product *= float64(mm.Normalized[i][j])
+		}
+		mm.Border[j] = eval.Number(math.Pow(product, 1/float64(mm.CountAlternatives)))
+	}
+	mm.BorderFind = true
+}
+
+func (mm *MabacMatrix) CalcDistances(g int) {
+	var wg sync.WaitGroup
+	if g > mm.CountAlternatives {
+		g = mm.CountAlternatives
+	}
+	off := mm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = mm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				distance := eval.Number(0)
+				for j := 0; j < mm.CountCriteria; j++ {
+					distance += mm.Normalized[i][j] - mm.Border[j]
+				}
+				mm.Distances[i] = eval.Rating{Evaluated: distance}
+			}
+		}(b)
+	}
+	wg.Wait()
+	mm.DistancesFind = true
+}
+
+func (mm *MabacMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(mm.Distances))
+	ind := make([]int, len(mm.Distances))
+	for i := range set {
+		ind[i] = i
+		set[i] = mm.Distances[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], mm.Defuzzification) > eval.Crisp(set[ind[j]], mm.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], mm.Defuzzification) > eval.Crisp(set[j], mm.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
@@ -0,0 +1,148 @@
+package mabac
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func MabacCalculating(mabacMatrix *MabacMatrix, weightNorm v.Variants) ([]eval.Rating, error) {
+	if err := mabacMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, err
+	}
+
+	mabacMatrix.MinMaxNormalization()
+
+	mabacMatrix.FindBorderArea()
+
+	mabacMatrix.CalcDistances(5)
+
+	return mabacMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *MabacMatrix
+		weightNorm v.Variants
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToMabacMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			resultRow:  []eval.Number{0.287, 0.233, -0.157, -0.130},
+		},
+		{
+			initMat:    ConvertToMabacMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{0.087, 0.142, -0.027},
+		},
+		{
+			initMat:    ConvertToMabacMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{-0.095, -0.131, 0.299, 0.129},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := MabacCalculating(tt.initMat, tt.weightNorm); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCostCriterion(t *testing.T) {
+	var tests = []struct {
+		ratings   [][]eval.Number
+		types     []bool
+		resultRow []eval.Number
+		order     []int
+	}{
+		{
+			ratings:   [][]eval.Number{{1}, {5}, {9}},
+			types:     []bool{v.Cost},
+			resultRow: []eval.Number{0.558, 0.058, -0.442},
+			order:     []int{0, 1, 2},
+		},
+		{
+			ratings:   [][]eval.Number{{4, 1}, {2, 5}, {8, 9}},
+			types:     []bool{v.Benefit, v.Cost},
+			resultRow: []eval.Number{0.252, -0.164, 0.086},
+			order:     []int{0, 2, 1},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			m := NewMabacMatrix(len(tt.ratings), len(tt.types))
+			for k, row := range tt.ratings {
+				for j := range row {
+					_ = m.SetValue(row[j], k, j)
+				}
+			}
+			for j, typeOf := range tt.types {
+				_ = m.SetCriterion(eval.Number(1), typeOf, j)
+			}
+
+			if res, err := MabacCalculating(m, v.NormalizeWithSum); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+
+				if list := m.RankedList(); !reflect.DeepEqual(list.Order, tt.order) {
+					t.Errorf("got %v, want %v", list.Order, tt.order)
+				}
+			}
+		})
+	}
+}
+
+func TestDefuzzificationStrategy(t *testing.T) {
+	var tests = []struct {
+		method v.Variants
+		order  []int
+	}{
+		{method: v.Centroid, order: []int{0, 1}},
+		{method: v.GradedMeanIntegration, order: []int{1, 0}},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			m := NewMabacMatrix(2, 1)
+			_ = m.SetValue(eval.NewT1FS(0, 1, 10), 0, 0)
+			_ = m.SetValue(eval.NewT1FS(2, 3, 4), 1, 0)
+			_ = m.SetCriterion(eval.Number(1), v.Benefit, 0)
+			m.Defuzzification = tt.method
+
+			if _, err := MabacCalculating(m, v.NormalizeWithSum); err != nil {
+				t.Errorf(err.Error())
+			} else if list := m.RankedList(); !reflect.DeepEqual(list.Order, tt.order) {
+				t.Errorf("got %v, want %v", list.Order, tt.order)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package mabac
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type MabacMatrix struct {
+	*matrix.Matrix
+	Normalized     [][]eval.Number `json:"normalized"`
+	NormalizedFind bool            `json:"is_normalized_find"`
+	Border         []eval.Number   `json:"border"`
+	BorderFind     bool            `json:"is_border_find"`
+	Distances      []eval.Rating   `json:"distances"`
+	DistancesFind  bool            `json:"is_dist_find"`
+}
+
+func NewMabacMatrix(x, y int) *MabacMatrix {
+	return &MabacMatrix{
+		Matrix:     matrix.NewMatrix(x, y),
+		Normalized: newNormalized(x, y),
+		Border:     make([]eval.Number, y),
+		Distances:  make([]eval.Rating, x),
+	}
+}
+
+func ConvertToMabacMatrix(m *matrix.Matrix) *MabacMatrix {
+	return &MabacMatrix{
+		Matrix:     matrix.CopyMatrix(m),
+		Normalized: newNormalized(m.CountAlternatives, m.CountCriteria),
+		Border:     make([]eval.Number, m.CountCriteria),
+		Distances:  make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func newNormalized(x, y int) [][]eval.Number {
+	normalized := make([][]eval.Number, x)
+	for i := range normalized {
+		normalized[i] = make([]eval.Number, y)
+	}
+	return normalized
+}
+
+func (mm *MabacMatrix) GetCoefs() []eval.Rating {
+	return mm.Distances
+}
+
+func (mm *MabacMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range mm.Data {
+		s += mm.Data[i].String() + "\n"
+	}
+
+	if mm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < mm.CountCriteria; i++ {
+			s += mm.Criteria[i].String() + " "
+		}
+	}
+
+	if mm.BorderFind {
+		s += "\nBorder approximation area:\n"
+		for j := 0; j < mm.CountCriteria; j++ {
+			s += mm.Border[j].String() + " "
+		}
+	}
+
+	if mm.DistancesFind {
+		s += "\nDistances from border area:\n"
+		for i := 0; i < mm.CountAlternatives; i++ {
+			s += mm.Distances[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateDistances(matrices []MabacMatrix, weights []eval.Evaluated) (*MabacMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewMabacMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i, dist := range matrices[k].Distances {
+			if k == 0 {
+				result.Distances[i] = dist.Weighted(weights[k])
+				continue
+			}
+			result.Distances[i] = result.Distances[i].Sum(dist.Weighted(weights[k]))
+		}
+	}
+	result.DistancesFind = true
+	return result, nil
+}
@@ -28,14 +28,22 @@ func (a *Alternative) String() string {
 	return s + " ]"
 }
 
-func (a *Alternative) NumberMetric(to Alternative, vn v.Variants) (eval.Number, error) {
+// NumberMetric measures distance between ratings turned into numbers by
+// the defuzzification method df, where the α-cut average keeps own ratings
+// and compares their cuts to the values of the other alternative.
+func (a *Alternative) NumberMetric(to Alternative, vn, df v.Variants) (eval.Number, error) {
 	if a.CountOfCriteria != to.CountOfCriteria {
 		return 0, v.InvalidSize
 	}
 
 	result := eval.Number(0)
 	for i := 0; i < a.CountOfCriteria; i++ {
-		if tmp, err := a.Grade[i].DiffNumber(to.Grade[i].ConvertToNumber(), vn); err != nil {
+		own, other := eval.Evaluated(a.Grade[i]), eval.Evaluated(to.Grade[i].ConvertToNumber())
+		if df != v.AlphaCutAverage {
+			own, other = eval.Crisp(a.Grade[i], df), eval.Crisp(to.Grade[i], df)
+		}
+
+		if tmp, err := own.DiffNumber(other, vn); err != nil {
 			return 0, err
 		} else {
 			result += tmp
@@ -44,7 +52,7 @@ func (a *Alternative) NumberMetric(to Alternative, vn v.Variants) (eval.Number,
 
 	if vn == v.SqrtDistance {
 		result = eval.Number(math.Sqrt(float64(result)))
-	} else {
+	} else if vn != v.HammingDistance {
 		result = eval.Number(math.Cbrt(float64(result)))
 	}
 
@@ -92,7 +100,7 @@ func (a *Alternative) FSMetric(to Alternative, vn v.Variants) (eval.Number, erro
 
 	if vn == v.SqrtDistance {
 		result = eval.Number(math.Sqrt(float64(result)))
-	} else {
+	} else if vn != v.HammingDistance {
 		result = eval.Number(math.Cbrt(float64(result)))
 	}
 
@@ -106,11 +114,15 @@ func (a *Alternative) Sum() eval.Rating {
 		sum = eval.Number(0.0)
 	} else if a.Grade[0].GetType() == (eval.Interval{}).GetType() {
 		sum = eval.Interval{}
+	} else if a.Grade[0].GetType() == (eval.Grey{}).GetType() {
+		sum = eval.Grey{}
 	} else if a.Grade[0].GetType() == (&eval.T1FS{}).GetType() {
 		if a.Grade[0].ConvertToT1FS(v.Default).Form == v.Triangle {
 			sum = eval.NewT1FS(0.0, 0.0, 0.0)
-		} else {
+		} else if a.Grade[0].ConvertToT1FS(v.Default).Form == v.Trapezoid {
 			sum = eval.NewT1FS(0.0, 0.0, 0.0, 0.0)
+		} else {
+			sum = a.Grade[0].Weighted(eval.Number(0))
 		}
 	} else if a.Grade[0].GetType() == (&eval.AIFS{}).GetType() {
 		if a.Grade[0].ConvertToAIFS(v.Default).Form == v.Triangle {
@@ -118,6 +130,16 @@ func (a *Alternative) Sum() eval.Rating {
 		} else {
 			sum = eval.NewAIFS(0.0, 0.0, 0.0, 0.0, 0.0)
 		}
+	} else if a.Grade[0].GetType() == (&eval.PFS{}).GetType() {
+		if a.Grade[0].ConvertToPFS(v.Default).Form == v.Triangle {
+			sum = eval.NewPFS(1.0, 0.0, 0.0, 0.0, 0.0)
+		} else {
+			sum = eval.NewPFS(1.0, 0.0, 0.0, 0.0, 0.0, 0.0)
+		}
+	} else if a.Grade[0].GetType() == (&eval.HFS{}).GetType() {
+		sum = eval.NewHFS(0.0)
+	} else if a.Grade[0].GetType() == (&eval.NS{}).GetType() {
+		sum = eval.NewNS(0.0, 1.0, 1.0)
 	} else if a.Grade[0].GetType() == (&eval.IT2FS{}).GetType() {
 		if a.Grade[0].ConvertToIT2FS(v.Default).Form == v.Triangle {
 			sum = eval.NewIT2FS([]eval.Interval{{0.0, 0.0}, {0.0, 0.0}}, []eval.Number{0.0})
@@ -6,9 +6,18 @@ import (
 	v "webApp/lib/variables"
 )
 
+type Thresholds struct {
+	Shape        v.Variants `json:"shape"`
+	Indifference float64    `json:"q"`
+	Preference   float64    `json:"p"`
+	Gaussian     float64    `json:"s"`
+	Veto         float64    `json:"v"`
+}
+
 type Criterion struct {
 	Weight         eval.Rating `json:"weight"`
 	TypeOfCriteria bool        `json:"type_of_crit"`
+	Thresholds     Thresholds  `json:"thresholds"`
 }
 
 func NewCriteria(size int) []Criterion {
@@ -21,7 +30,7 @@ func NewCriteria(size int) []Criterion {
 }
 
 func CopyCriterion(c Criterion) Criterion {
-	return Criterion{Weight: c.Weight.CopyEval(), TypeOfCriteria: c.TypeOfCriteria}
+	return Criterion{Weight: c.Weight.CopyEval(), TypeOfCriteria: c.TypeOfCriteria, Thresholds: c.Thresholds}
 }
 
 func (c *Criterion) set(value eval.Evaluated, typeOF bool) {
@@ -44,7 +53,8 @@ func (c *Criterion) String() string {
 func ChangeTypes(Criteria []Criterion) []Criterion {
 	newCriteria := make([]Criterion, len(Criteria))
 	for i := range newCriteria {
-		newCriteria[i] = Criterion{Weight: Criteria[i].Weight, TypeOfCriteria: !Criteria[i].TypeOfCriteria}
+		newCriteria[i] = Criterion{Weight: Criteria[i].Weight, TypeOfCriteria: !Criteria[i].TypeOfCriteria,
+			Thresholds: Criteria[i].Thresholds}
 	}
 	return newCriteria
 }
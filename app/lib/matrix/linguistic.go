@@ -0,0 +1,146 @@
+package matrix
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"webApp/lib/eval"
+)
+
+// LinguisticSummary expresses aggregated group ratings and final coefficients
+// in terms of the linguistic scale of the task as 2-tuples.
+type LinguisticSummary struct {
+	Ratings [][]eval.TwoTuple `json:"ratings"`
+	Coeffs  []eval.TwoTuple   `json:"coeffs,omitempty"`
+}
+
+// AggregateTerms averages ratings of experts as 2-tuples, weighted by
+// the weights of experts or equally when none are given. Like
+// AlternativesReliability it must be called before typing, which drops
+// linguistic marks.
+func AggregateTerms(scale *eval.LinguisticScale, matrices []Matrix, weights []eval.Evaluated) (*LinguisticSummary, error) {
+	if len(matrices) == 0 {
+		return nil, nil
+	}
+
+	if len(weights) != 0 && len(weights) != len(matrices) {
+		return nil, errors.New("incompatible sizes of matrices and weights")
+	}
+
+	expertsWeights := make([]eval.Number, len(matrices))
+	for k := range expertsWeights {
+		expertsWeights[k] = 1
+		if len(weights) != 0 {
+			expertsWeights[k] = weights[k].ConvertToNumber()
+		}
+	}
+
+	summary := LinguisticSummary{Ratings: make([][]eval.TwoTuple, matrices[0].CountAlternatives)}
+	values := make([]eval.TwoTuple, len(matrices))
+	for i := range summary.Ratings {
+		summary.Ratings[i] = make([]eval.TwoTuple, matrices[0].CountCriteria)
+		for j := range summary.Ratings[i] {
+			for k := range matrices {
+				tuple, err := scale.Express(matrices[k].Data[i].Grade[j])
+				if err != nil {
+					return nil, err
+				}
+				values[k] = tuple
+			}
+
+			tuple, err := scale.WeightedAverage(values, expertsWeights)
+			if err != nil {
+				return nil, err
+			}
+			summary.Ratings[i][j] = tuple
+		}
+	}
+	return &summary, nil
+}
+
+// UnifyScale rewrites linguistic ratings given on the scale of an expert as
+// ratings of the basic linguistic term set, carrying each term over with
+// the 2-tuple transformation between scales of different granularity.
+// Ratings given by value rather than by term are left as they are.
+func (m *Matrix) UnifyScale(from, to *eval.LinguisticScale) error {
+	for i := range m.Data {
+		for j := range m.Data[i].Grade {
+			ling, ok := m.Data[i].Grade[j].Evaluated.(eval.Linguistic)
+			if !ok {
+				continue
+			}
+
+			for term := range from.Marks {
+				if from.Marks[term] != ling.Mark {
+					continue
+				}
+
+				tuple, err := from.Transform(eval.TwoTuple{Term: term, Mark: ling.Mark}, to)
+				if err != nil {
+					return err
+				}
+
+				if err = m.SetValue(to.Rating(tuple), i, j); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// SetCoeffs expresses coefficients of the ranked list as 2-tuples in rank
+// order. Coefficients of a unit list already lie in the unit interval with
+// larger values better and are mapped onto the scale as they are; any other
+// list is first rescaled so that its best alternative takes the top term and
+// its worst the bottom one, which holds whichever way the method orders them.
+func (s *LinguisticSummary) SetCoeffs(scale *eval.LinguisticScale, list RankedList, unit bool) error {
+	s.Coeffs = make([]eval.TwoTuple, len(list.Coeffs))
+	if len(list.Coeffs) == 0 {
+		return nil
+	}
+
+	best, worst := eval.Defuzzify(list.Coeffs[0]), eval.Defuzzify(list.Coeffs[len(list.Coeffs)-1])
+	for i := range list.Coeffs {
+		x := eval.Defuzzify(list.Coeffs[i])
+		if !unit {
+			x = 1
+			if best != worst {
+				x = (eval.Defuzzify(list.Coeffs[i]) - worst) / (best - worst)
+			}
+		}
+
+		tuple, err := scale.ExpressUnit(x)
+		if err != nil {
+			return err
+		}
+		s.Coeffs[i] = tuple
+	}
+	return nil
+}
+
+func (s LinguisticSummary) Value() (driver.Value, error) {
+	data, err := json.Marshal(s)
+	return string(data), err
+}
+
+func (s *LinguisticSummary) Scan(src interface{}) error {
+	var tmp LinguisticSummary
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for LinguisticSummary")
+	}
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
@@ -17,6 +17,7 @@ type Matrix struct {
 	CriteriaSet       bool          `json:"is_crit_set"`
 	HighType          string        `json:"high_type"`
 	FormFs            v.Variants    `json:"form_fs"`
+	Defuzzification   v.Variants    `json:"defuzzification"`
 }
 
 func NewMatrix(x, y int) *Matrix {
@@ -118,6 +119,7 @@ func (m *Matrix) SetCriteria(criteria []Criterion) error {
 
 	for i := 0; i < m.CountCriteria; i++ {
 		m.Criteria[i].set(criteria[i].Weight, criteria[i].TypeOfCriteria)
+		m.Criteria[i].Thresholds = criteria[i].Thresholds
 	}
 	m.CriteriaSet = true
 	return nil
@@ -133,15 +135,32 @@ func (m *Matrix) SetCriterion(Weight eval.Evaluated, typeOf bool, i int) error {
 	return nil
 }
 
+func (m *Matrix) SetThresholds(thresholds Thresholds, i int) error {
+	if i < m.CountCriteria {
+		m.Criteria[i].Thresholds = thresholds
+	} else {
+		return v.OutOfBounds
+	}
+	return nil
+}
+
 func (m *Matrix) castToType(t string, f v.Variants) {
 	for i := range m.Data {
 		for c := range m.Data[i].Grade {
 			if t == (&eval.IT2FS{}).GetType() {
 				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToIT2FS(f)
+			} else if t == (&eval.NS{}).GetType() {
+				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToNS(f)
+			} else if t == (&eval.HFS{}).GetType() {
+				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToHFS(f)
+			} else if t == (&eval.PFS{}).GetType() {
+				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToPFS(f)
 			} else if t == (&eval.AIFS{}).GetType() {
 				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToAIFS(f)
 			} else if t == (&eval.T1FS{}).GetType() {
 				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToT1FS(f)
+			} else if t == (eval.Grey{}).GetType() {
+				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToGrey()
 			} else if t == (eval.Interval{}).GetType() {
 				m.Data[i].Grade[c].Evaluated = m.Data[i].Grade[c].ConvertToInterval()
 			}
@@ -149,6 +168,20 @@ func (m *Matrix) castToType(t string, f v.Variants) {
 	}
 }
 
+// SetHesitance sets how hesitant ratings of the matrix are extended before
+// comparison, see eval.HFS.
+func (m *Matrix) SetHesitance(optimistic bool) {
+	for i := range m.Data {
+		for c := range m.Data[i].Grade {
+			if m.Data[i].Grade[c].GetType() == (&eval.HFS{}).GetType() {
+				if h := m.Data[i].Grade[c].ConvertToHFS(v.Default); h != nil {
+					h.Optimistic = optimistic
+				}
+			}
+		}
+	}
+}
+
 func TypingMatrices(g int, matrices ...Matrix) error {
 	x, y := matrices[0].CountAlternatives, matrices[0].CountCriteria
 	var highestType string
@@ -223,6 +256,7 @@ func TypingMatrices(g int, matrices ...Matrix) error {
 
 func AggregateRatings(matrices []Matrix, weights []eval.Evaluated, g int) (*Matrix, error) {
 	result := NewMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
 	if err := TypingMatrices(g, matrices...); err != nil {
 		return nil, err
 	}
@@ -262,6 +296,7 @@ func AggregateRatings(matrices []Matrix, weights []eval.Evaluated, g int) (*Matr
 
 	for i := range result.Criteria {
 		result.Criteria[i].set(matrices[0].Criteria[i].Weight, matrices[0].Criteria[i].TypeOfCriteria)
+		result.Criteria[i].Thresholds = matrices[0].Criteria[i].Thresholds
 	}
 	return result, nil
 }
@@ -276,5 +311,6 @@ func CopyMatrix(matrix *Matrix) *Matrix {
 	for i := range newMatrix.Criteria {
 		newMatrix.Criteria[i] = CopyCriterion(matrix.Criteria[i])
 	}
+	newMatrix.Defuzzification = matrix.Defuzzification
 	return newMatrix
 }
@@ -3,6 +3,7 @@ package matrix
 import (
 	"context"
 	"math"
+	"slices"
 	"sync"
 	"webApp/lib/eval"
 	v "webApp/lib/variables"
@@ -19,6 +20,14 @@ func getBestValueWithCond(a, b eval.Evaluated, typeOfCriterion bool) eval.Number
 			}
 		}
 
+		if val[i].GetType() == (eval.Grey{}).GetType() {
+			if typeOfCriterion == v.Benefit {
+				val[i] = val[i].ConvertToGrey().Upper
+			} else {
+				val[i] = val[i].ConvertToGrey().Lower
+			}
+		}
+
 		if val[i].GetType() == (&eval.T1FS{}).GetType() {
 			if typeOfCriterion == v.Benefit {
 				val[i] = val[i].ConvertToT1FS(v.Default).Vert[len(val[i].ConvertToT1FS(v.Default).Vert)-1]
@@ -35,6 +44,14 @@ func getBestValueWithCond(a, b eval.Evaluated, typeOfCriterion bool) eval.Number
 			}
 		}
 
+		if val[i].GetType() == (&eval.PFS{}).GetType() {
+			if typeOfCriterion == v.Benefit {
+				val[i] = val[i].ConvertToPFS(v.Default).Vert[len(val[i].ConvertToPFS(v.Default).Vert)-1]
+			} else {
+				val[i] = val[i].ConvertToPFS(v.Default).Vert[0]
+			}
+		}
+
 		if val[i].GetType() == (&eval.IT2FS{}).GetType() {
 			if typeOfCriterion == v.Benefit {
 				val[i] = val[i].ConvertToIT2FS(v.Default).Bottom[1].End
@@ -69,6 +86,15 @@ func getNormValueByMax(e eval.Evaluated, min, max eval.Number, typeOfCriterion b
 				e.ConvertToAIFS(v.Default).Vert[len(vertices)-k-1]
 		}
 		e.ConvertToAIFS(v.Default).Vert = vertices
+	} else if e.GetType() == (&eval.PFS{}).GetType() &&
+		typeOfCriterion == v.Cost {
+		vertices := make([]eval.Number, len(e.ConvertToPFS(v.Default).Vert))
+
+		for k := range vertices {
+			vertices[k] = min /
+				e.ConvertToPFS(v.Default).Vert[len(vertices)-k-1]
+		}
+		e.ConvertToPFS(v.Default).Vert = vertices
 	} else if e.GetType() == (&eval.IT2FS{}).GetType() &&
 		typeOfCriterion == v.Cost {
 		grade := e.ConvertToIT2FS(v.Default)
@@ -99,7 +125,7 @@ func (m *Matrix) getMinMaxRecord(j int) (eval.Number, eval.Number) {
 		rating := m.Data[i].Grade[j]
 
 		if rating.GetType() == (&eval.T1FS{}).GetType() || rating.GetType() == (&eval.AIFS{}).GetType() ||
-			rating.GetType() == (&eval.IT2FS{}).GetType() {
+			rating.GetType() == (&eval.PFS{}).GetType() || rating.GetType() == (&eval.IT2FS{}).GetType() {
 			if m.Criteria[j].TypeOfCriteria == v.Benefit {
 				maximum = getBestValueWithCond(maximum, rating, m.Criteria[j].TypeOfCriteria)
 			} else {
@@ -122,12 +148,18 @@ func (m *Matrix) getSumForCriterion(j int) eval.Number {
 		} else if rating.GetType() == (eval.Interval{}).GetType() {
 			sum += rating.ConvertToInterval().Start*rating.ConvertToInterval().Start +
 				rating.ConvertToInterval().End*rating.ConvertToInterval().End
+		} else if rating.GetType() == (eval.Grey{}).GetType() {
+			tmp := rating.ConvertToGrey()
+			sum += tmp.Lower*tmp.Lower + tmp.Upper*tmp.Upper
 		} else if rating.GetType() == (&eval.T1FS{}).GetType() {
 			tmp := rating.ConvertToT1FS(v.Default)
 			sum += tmp.Vert[0]*tmp.Vert[0] + tmp.Vert[len(tmp.Vert)-1]*tmp.Vert[len(tmp.Vert)-1]
 		} else if rating.GetType() == (&eval.AIFS{}).GetType() {
 			tmp := rating.ConvertToAIFS(v.Default)
 			sum += tmp.Vert[0]*tmp.Vert[0] + tmp.Vert[len(tmp.Vert)-1]*tmp.Vert[len(tmp.Vert)-1]
+		} else if rating.GetType() == (&eval.PFS{}).GetType() {
+			tmp := rating.ConvertToPFS(v.Default)
+			sum += tmp.Vert[0]*tmp.Vert[0] + tmp.Vert[len(tmp.Vert)-1]*tmp.Vert[len(tmp.Vert)-1]
 		} else if rating.GetType() == (&eval.IT2FS{}).GetType() {
 			tmp := rating.ConvertToIT2FS(v.Default)
 			sum += tmp.Bottom[0].Start*tmp.Bottom[0].Start + tmp.Bottom[1].End*tmp.Bottom[1].End
@@ -138,6 +170,18 @@ func (m *Matrix) getSumForCriterion(j int) eval.Number {
 	return sum
 }
 
+// isDegreesCriterion reports whether ratings of criterion are neutrosophic or
+// hesitant degrees, which already lie on the unit scale and are never normalized.
+func (m *Matrix) isDegreesCriterion(j int) bool {
+	for i := range m.Data {
+		if m.Data[i].Grade[j].GetType() != (&eval.NS{}).GetType() &&
+			m.Data[i].Grade[j].GetType() != (&eval.HFS{}).GetType() {
+			return false
+		}
+	}
+	return len(m.Data) > 0
+}
+
 func (m *Matrix) normalizationValue(variants v.Variants, g int) error {
 	var wg sync.WaitGroup
 	var err error = nil
@@ -159,6 +203,10 @@ func (m *Matrix) normalizationValue(variants v.Variants, g int) error {
 				}
 
 				for c := start; c < end; c++ {
+					if m.isDegreesCriterion(c) {
+						continue
+					}
+
 					sum := m.getSumForCriterion(c)
 
 					if sum == 0.0 {
@@ -186,6 +234,10 @@ func (m *Matrix) normalizationValue(variants v.Variants, g int) error {
 				}
 
 				for c := start; c < end; c++ {
+					if m.isDegreesCriterion(c) {
+						continue
+					}
+
 					criterion := m.Criteria[c]
 					minimum, maximum := m.getMinMaxRecord(c)
 
@@ -208,7 +260,7 @@ func (m *Matrix) normalizationValue(variants v.Variants, g int) error {
 	return err
 }
 
-func (m *Matrix) normalizationWeights(variants v.Variants) error {
+func (m *Matrix) NormalizationWeights(variants v.Variants) error {
 	highSum := eval.Number(0.0)
 	lowerSum := eval.Number(0.0)
 
@@ -266,7 +318,7 @@ func (m *Matrix) Normalization(values v.Variants, weights v.Variants, g int) err
 		case <-ctx.Done():
 			return
 		default:
-			if inerr := m.normalizationWeights(weights); inerr != nil {
+			if inerr := m.NormalizationWeights(weights); inerr != nil {
 				cancel()
 				err = inerr
 			}
@@ -304,3 +356,59 @@ func (m *Matrix) CalcWeightedMatrix(g int) {
 	}
 	wg.Wait()
 }
+
+func (m *Matrix) crispColumn(j int) []eval.Number {
+	column := make([]eval.Number, len(m.Data))
+	for i := range m.Data {
+		column[i] = eval.Crisp(m.Data[i].Grade[j], m.Defuzzification)
+	}
+	return column
+}
+
+// LinearNormalization maps the defuzzified ratings of every criterion onto
+// [0, 1] by the range of its column: benefit ratings as (x-min)/(max-min) and
+// cost ratings as (max-x)/(max-min), so that 1 is always the best value.
+// A column without spread is mapped to 0.
+func (m *Matrix) LinearNormalization() [][]eval.Number {
+	normalized := make([][]eval.Number, len(m.Data))
+	for i := range normalized {
+		normalized[i] = make([]eval.Number, m.CountCriteria)
+	}
+
+	for j, c := range m.Criteria {
+		column := m.crispColumn(j)
+		minimum, maximum := slices.Min(column), slices.Max(column)
+		for i := range column {
+			if maximum == minimum {
+				normalized[i][j] = 0
+			} else if c.TypeOfCriteria == v.Cost {
+				normalized[i][j] = (maximum - column[i]) / (maximum - minimum)
+			} else {
+				normalized[i][j] = (column[i] - minimum) / (maximum - minimum)
+			}
+		}
+	}
+	return normalized
+}
+
+// BenefitOriented returns the defuzzified ratings with cost ratings replaced
+// by their reciprocals, so that larger is better on every criterion.
+func (m *Matrix) BenefitOriented() ([][]eval.Number, error) {
+	oriented := make([][]eval.Number, len(m.Data))
+	for i := range oriented {
+		oriented[i] = make([]eval.Number, m.CountCriteria)
+	}
+
+	for j, c := range m.Criteria {
+		for i, x := range m.crispColumn(j) {
+			if c.TypeOfCriteria == v.Cost {
+				if x == 0 {
+					return nil, v.EmptyValues
+				}
+				x = 1 / x
+			}
+			oriented[i][j] = x
+		}
+	}
+	return oriented, nil
+}
@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"math"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+func (m *Matrix) crispColumns() [][]float64 {
+	columns := make([][]float64, m.CountCriteria)
+	for j := range columns {
+		columns[j] = make([]float64, m.CountAlternatives)
+		lowest := math.Inf(1)
+		for i := range m.Data {
+			columns[j][i] = float64(eval.Crisp(m.Data[i].Grade[j], m.Defuzzification))
+			lowest = math.Min(lowest, columns[j][i])
+		}
+
+		if lowest <= 0 {
+			for i := range columns[j] {
+				columns[j][i] += 1 - lowest
+			}
+		}
+	}
+	return columns
+}
+
+func normalizeCoefs(coefs []float64) []eval.Number {
+	sum := 0.
+	for _, c := range coefs {
+		sum += c
+	}
+
+	weights := make([]eval.Number, len(coefs))
+	for j := range weights {
+		if sum == 0 {
+			weights[j] = 1 / eval.Number(len(coefs))
+		} else {
+			weights[j] = eval.Number(coefs[j] / sum)
+		}
+	}
+	return weights
+}
+
+func (m *Matrix) EntropyWeights() []eval.Number {
+	columns := m.crispColumns()
+	divergence := make([]float64, m.CountCriteria)
+	if m.CountAlternatives < 2 {
+		return normalizeCoefs(divergence)
+	}
+
+	for j, column := range columns {
+		sum := 0.
+		for _, x := range column {
+			sum += x
+		}
+
+		entropy := 0.
+		for _, x := range column {
+			if p := x / sum; p > 0 {
+				entropy -= p * math.Log(p)
+			}
+		}
+		divergence[j] = 1 - entropy/math.Log(float64(m.CountAlternatives))
+	}
+	return normalizeCoefs(divergence)
+}
+
+func (m *Matrix) CriticWeights() []eval.Number {
+	columns := m.crispColumns()
+	means := make([]float64, m.CountCriteria)
+	deviations := make([]float64, m.CountCriteria)
+	for j, column := range columns {
+		lowest, highest := column[0], column[0]
+		for _, x := range column {
+			lowest, highest = math.Min(lowest, x), math.Max(highest, x)
+		}
+
+		for i, x := range column {
+			if highest == lowest {
+				column[i] = 0
+			} else if m.Criteria[j].TypeOfCriteria == v.Benefit {
+				column[i] = (x - lowest) / (highest - lowest)
+			} else {
+				column[i] = (highest - x) / (highest - lowest)
+			}
+			means[j] += column[i]
+		}
+		means[j] /= float64(m.CountAlternatives)
+
+		for _, x := range column {
+			deviations[j] += (x - means[j]) * (x - means[j])
+		}
+		deviations[j] = math.Sqrt(deviations[j] / float64(m.CountAlternatives))
+	}
+
+	information := make([]float64, m.CountCriteria)
+	for j := range columns {
+		conflict := 0.
+		for k := range columns {
+			correlation := 0.
+			if deviations[j] != 0 && deviations[k] != 0 {
+				for i := range columns[j] {
+					correlation += (columns[j][i] - means[j]) * (columns[k][i] - means[k])
+				}
+				correlation /= float64(m.CountAlternatives) * deviations[j] * deviations[k]
+			}
+			conflict += 1 - correlation
+		}
+		information[j] = deviations[j] * conflict
+	}
+	return normalizeCoefs(information)
+}
+
+func (m *Matrix) MerecWeights() []eval.Number {
+	columns := m.crispColumns()
+	logs := make([][]float64, m.CountAlternatives)
+	for i := range logs {
+		logs[i] = make([]float64, m.CountCriteria)
+	}
+
+	for j, column := range columns {
+		lowest, highest := column[0], column[0]
+		for _, x := range column {
+			lowest, highest = math.Min(lowest, x), math.Max(highest, x)
+		}
+
+		for i, x := range column {
+			if m.Criteria[j].TypeOfCriteria == v.Benefit {
+				logs[i][j] = math.Abs(math.Log(lowest / x))
+			} else {
+				logs[i][j] = math.Abs(math.Log(x / highest))
+			}
+		}
+	}
+
+	removal := make([]float64, m.CountCriteria)
+	n := float64(m.CountCriteria)
+	for i := range logs {
+		total := 0.
+		for _, l := range logs[i] {
+			total += l
+		}
+
+		performance := math.Log(1 + total/n)
+		for j, l := range logs[i] {
+			removal[j] += math.Abs(math.Log(1+(total-l)/n) - performance)
+		}
+	}
+	return normalizeCoefs(removal)
+}
+
+func (m *Matrix) ObjectiveWeights(source v.Variants) ([]eval.Number, error) {
+	if m.CountAlternatives == 0 || m.CountCriteria == 0 {
+		return nil, v.EmptyValues
+	}
+
+	if source == v.EntropyWeights {
+		return m.EntropyWeights(), nil
+	} else if source == v.CriticWeights {
+		return m.CriticWeights(), nil
+	} else if source == v.MerecWeights {
+		return m.MerecWeights(), nil
+	}
+	return nil, v.InvalidCaseOfOperation
+}
+
+func (m *Matrix) SetObjectiveWeights(source, mixing v.Variants, coefficient eval.Number) error {
+	if source == v.SubjectiveWeights {
+		return nil
+	}
+
+	weights, err := m.ObjectiveWeights(source)
+	if err != nil {
+		return err
+	}
+
+	if mixing == v.ObjectiveOnly {
+		for j := range m.Criteria {
+			m.Criteria[j].Weight = eval.Rating{Evaluated: weights[j]}
+		}
+	} else if mixing == v.MultiplicativeMix {
+		for j := range m.Criteria {
+			m.Criteria[j].Weight = m.Criteria[j].Weight.Weighted(weights[j])
+		}
+	} else if mixing == v.LinearMix {
+		sum := eval.Number(0)
+		for _, c := range m.Criteria {
+			sum += eval.Crisp(c.Weight, m.Defuzzification)
+		}
+
+		if sum == 0 {
+			return v.EmptyValues
+		}
+
+		for j, c := range m.Criteria {
+			m.Criteria[j].Weight = eval.Rating{Evaluated: coefficient*eval.Crisp(c.Weight, m.Defuzzification)/sum + (1-coefficient)*weights[j]}
+		}
+	} else {
+		return v.InvalidCaseOfOperation
+	}
+
+	m.CriteriaSet = true
+	return nil
+}
@@ -0,0 +1,93 @@
+package matrix
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+	"math"
+	"testing"
+	"webApp/lib/eval"
+	v "webApp/lib/variables"
+)
+
+func TestObjectiveWeights(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	m := NewMatrix(3, 2)
+	err := m.setRatings([][]eval.Evaluated{
+		{eval.Number(1), eval.Number(2).ConvertToInterval()},
+		{eval.Number(2).ConvertToT1FS(v.Triangle), eval.Number(2)},
+		{eval.Number(3), eval.Interval{Start: 3, End: 5}},
+	})
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	err = m.SetCriteria([]Criterion{{Weight: eval.Rating{Evaluated: eval.Number(1)}, TypeOfCriteria: v.Benefit},
+		{Weight: eval.Rating{Evaluated: eval.Number(3)}, TypeOfCriteria: v.Cost}})
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	var tests = []struct {
+		source  v.Variants
+		weights []eval.Number
+	}{
+		{source: v.EntropyWeights, weights: []eval.Number{0.597, 0.403}},
+		{source: v.CriticWeights, weights: []eval.Number{0.464, 0.536}},
+		{source: v.MerecWeights, weights: []eval.Number{0.559, 0.441}},
+	}
+
+	for _, tt := range tests {
+		weights, err := m.ObjectiveWeights(tt.source)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+
+		for j := range weights {
+			if math.Abs(float64(weights[j]-tt.weights[j])) > 0.01 {
+				t.Errorf("source %d: got %f, want %f\n", tt.source, weights[j], tt.weights[j])
+			}
+		}
+	}
+
+	_, err = m.ObjectiveWeights(v.SubjectiveWeights)
+	assert.Equal(t, v.InvalidCaseOfOperation, err)
+}
+
+func TestMixWeights(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	m := NewMatrix(3, 2)
+	err := m.setRatings([][]eval.Evaluated{
+		{eval.Number(1), eval.Number(5)},
+		{eval.Number(2), eval.Number(5)},
+		{eval.Number(3), eval.Number(5)},
+	})
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	err = m.SetCriteria([]Criterion{{Weight: eval.Rating{Evaluated: eval.Number(1)}, TypeOfCriteria: v.Benefit},
+		{Weight: eval.Rating{Evaluated: eval.Number(3)}, TypeOfCriteria: v.Benefit}})
+
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	linear := CopyMatrix(m)
+	if err = linear.SetObjectiveWeights(v.EntropyWeights, v.LinearMix, 0.5); err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.InDelta(t, 0.625, float64(linear.Criteria[0].Weight.ConvertToNumber()), 0.01)
+	assert.InDelta(t, 0.375, float64(linear.Criteria[1].Weight.ConvertToNumber()), 0.01)
+
+	multiplicative := CopyMatrix(m)
+	if err = multiplicative.SetObjectiveWeights(v.EntropyWeights, v.MultiplicativeMix, 0); err != nil {
+		t.Fatalf(err.Error())
+	}
+	assert.InDelta(t, 1, float64(multiplicative.Criteria[0].Weight.ConvertToNumber()), 0.01)
+	assert.InDelta(t, 0, float64(multiplicative.Criteria[1].Weight.ConvertToNumber()), 0.01)
+}
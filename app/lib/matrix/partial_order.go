@@ -0,0 +1,68 @@
+package matrix
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strconv"
+	v "webApp/lib/variables"
+)
+
+type PartialOrder struct {
+	Relations [][]v.Variants `json:"relations"`
+}
+
+func NewPartialOrder(size int) PartialOrder {
+	p := PartialOrder{Relations: make([][]v.Variants, size)}
+	for i := range p.Relations {
+		p.Relations[i] = make([]v.Variants, size)
+		p.Relations[i][i] = v.Indifference
+	}
+	return p
+}
+
+func (p PartialOrder) String() string {
+	s := ""
+	for i := range p.Relations {
+		for j := i + 1; j < len(p.Relations[i]); j++ {
+			s += "alt №" + strconv.Itoa(i)
+			switch p.Relations[i][j] {
+			case v.Preference:
+				s += " P "
+			case v.InversePreference:
+				s += " P- "
+			case v.Indifference:
+				s += " I "
+			default:
+				s += " R "
+			}
+			s += "alt №" + strconv.Itoa(j) + "\n"
+		}
+	}
+	return s
+}
+
+func (p PartialOrder) Value() (driver.Value, error) {
+	data, err := json.Marshal(p)
+	return string(data), err
+}
+
+func (p *PartialOrder) Scan(src interface{}) error {
+	var tmp PartialOrder
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for PartialOrder")
+	}
+	if err != nil {
+		return err
+	}
+	*p = tmp
+	return nil
+}
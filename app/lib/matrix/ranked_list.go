@@ -45,3 +45,30 @@ func (r *RankedList) Scan(src interface{}) error {
 	*r = tmp
 	return nil
 }
+
+type RankedLists []RankedList
+
+func (r RankedLists) Value() (driver.Value, error) {
+	data, err := json.Marshal(r)
+	return string(data), err
+}
+
+func (r *RankedLists) Scan(src interface{}) error {
+	var tmp RankedLists
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for RankedLists")
+	}
+	if err != nil {
+		return err
+	}
+	*r = tmp
+	return nil
+}
@@ -0,0 +1,70 @@
+package matrix
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"webApp/lib/eval"
+)
+
+// Reliabilities holds the mean expected reliability of the ratings given to
+// each alternative across all matrices.
+type Reliabilities []eval.Number
+
+// AlternativesReliability averages reliabilities of ratings per alternative.
+// It must be called before typing, which turns Z-numbers into fuzzy numbers,
+// and returns nil when no rating carries a reliability.
+func AlternativesReliability(matrices []Matrix) *Reliabilities {
+	if len(matrices) == 0 {
+		return nil
+	}
+
+	hasZNumbers := false
+	result := make(Reliabilities, matrices[0].CountAlternatives)
+	for i := range result {
+		count := 0
+		for k := range matrices {
+			for _, rating := range matrices[k].Data[i].Grade {
+				if rating.GetType() == (&eval.ZNumber{}).GetType() {
+					hasZNumbers = true
+				}
+				result[i] += eval.Reliability(rating)
+				count++
+			}
+		}
+
+		if count > 0 {
+			result[i] /= eval.Number(count)
+		}
+	}
+
+	if !hasZNumbers {
+		return nil
+	}
+	return &result
+}
+
+func (r Reliabilities) Value() (driver.Value, error) {
+	data, err := json.Marshal(r)
+	return string(data), err
+}
+
+func (r *Reliabilities) Scan(src interface{}) error {
+	var tmp Reliabilities
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for Reliabilities")
+	}
+	if err != nil {
+		return err
+	}
+	*r = tmp
+	return nil
+}
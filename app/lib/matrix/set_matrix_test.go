@@ -42,3 +42,33 @@ func TestWrongSizeWeights(t *testing.T) {
 
 	assert.Equal(t, v.InvalidSize, err)
 }
+
+func TestScanCollidingLabels(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	m := NewMatrix(1, 3)
+	_ = m.SetValue(eval.Linguistic{Mark: "lower restriction", Rating: eval.Rating{Evaluated: eval.Number(3)}}, 0, 0)
+	_ = m.SetValue(eval.Linguistic{Mark: "hesitant truth", Rating: eval.Rating{Evaluated: eval.Interval{Start: 1, End: 2}}}, 0, 1)
+	_ = m.SetValue(eval.Linguistic{Mark: "\"nu\" vert pi", Rating: eval.Rating{Evaluated: eval.NewGrey(4, 5)}}, 0, 2)
+
+	data, err := m.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var scanned Matrix
+	if err = scanned.Scan(data); err != nil {
+		t.Fatal(err)
+	}
+	for j := range m.Data[0].Grade {
+		want, got := m.Data[0].Grade[j].Evaluated.(eval.Linguistic), scanned.Data[0].Grade[j].Evaluated
+		ling, ok := got.(eval.Linguistic)
+		if !ok || ling.Mark != want.Mark || ling.Rating.GetType() != want.Rating.GetType() ||
+			!ling.Rating.Equals(want.Rating.Evaluated) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+
+	var r eval.Rating
+	assert.Equal(t, v.IncompatibleTypes, r.UnmarshalJSON([]byte(`{"text": "lower restriction"}`)))
+}
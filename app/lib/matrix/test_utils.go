@@ -17,7 +17,7 @@ func GenerateMatrix(valueType, weightType reflect.Type, seed int64) *Matrix {
 		ceil = 2
 	} else if valueType == reflect.TypeOf(&eval.T1FS{}) {
 		ceil = 3
-	} else if valueType == reflect.TypeOf(&eval.AIFS{}) {
+	} else if valueType == reflect.TypeOf(&eval.AIFS{}) || valueType == reflect.TypeOf(&eval.PFS{}) {
 		ceil = 4
 	} else if valueType == reflect.TypeOf(&eval.IT2FS{}) {
 		ceil = 5
@@ -28,6 +28,52 @@ func GenerateMatrix(valueType, weightType reflect.Type, seed int64) *Matrix {
 
 	for i := 0; i < n; i++ {
 		for j := 0; j < m; j++ {
+			if valueType == reflect.TypeOf(&eval.HFS{}) {
+				values := make([]eval.Number, gen.Intn(3)+1)
+				for k := range values {
+					values[k] = eval.Number(gen.Float64())
+				}
+				_ = newMatrix.SetValue(eval.NewHFS(values...), i, j)
+				continue
+			}
+
+			if valueType == reflect.TypeOf(&eval.NS{}) {
+				degrees := make([]eval.Interval, 3)
+				interval := gen.Float64() > 0.5
+				for k := range degrees {
+					a := gen.Float64()
+					degrees[k] = eval.Interval{Start: eval.Number(a), End: eval.Number(a)}
+					if interval {
+						b := gen.Float64()
+						degrees[k] = eval.Interval{Start: eval.Number(math.Min(a, b)), End: eval.Number(math.Max(a, b))}
+					}
+				}
+				_ = newMatrix.SetValue(eval.NewIntervalNS(degrees[0], degrees[1], degrees[2]), i, j)
+				continue
+			}
+
+			if valueType == reflect.TypeOf(eval.Grey{}) {
+				_ = newMatrix.SetValue(eval.NewGrey(eval.Number(gen.Float64()*10), eval.Number(gen.Float64()*10)), i, j)
+				continue
+			}
+
+			if valueType == reflect.TypeOf(&eval.ZNumber{}) {
+				restriction := make([]eval.Number, 3)
+				reliability := make([]eval.Number, 3)
+				for k := range restriction {
+					restriction[k] = eval.Number(gen.Float64() * 10)
+					reliability[k] = eval.Number(gen.Float64())
+				}
+
+				for _, vert := range [][]eval.Number{restriction, reliability} {
+					sort.Slice(vert, func(i, j int) bool {
+						return vert[i] < vert[j]
+					})
+				}
+				_ = newMatrix.SetValue(eval.NewZNumber(eval.NewT1FS(restriction...), eval.NewT1FS(reliability...)), i, j)
+				continue
+			}
+
 			typeEval := gen.Intn(ceil) + 1
 
 			if typeEval == 1 {
@@ -68,7 +114,13 @@ func GenerateMatrix(valueType, weightType reflect.Type, seed int64) *Matrix {
 					return vert[i] < vert[j]
 				})
 
-				_ = newMatrix.SetValue(eval.NewAIFS(eval.Number(gen.Float64()), vert...), i, j)
+				if valueType == reflect.TypeOf(&eval.PFS{}) {
+					mu := gen.Float64()
+					nu := gen.Float64() * math.Sqrt(1-mu*mu)
+					_ = newMatrix.SetValue(eval.NewPFS(eval.Number(mu), eval.Number(nu), vert...), i, j)
+				} else {
+					_ = newMatrix.SetValue(eval.NewAIFS(eval.Number(gen.Float64()), vert...), i, j)
+				}
 			} else if typeEval == 5 {
 				var vert []eval.Number
 				if gen.Float64() > 0.5 {
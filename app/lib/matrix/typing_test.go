@@ -1,11 +1,14 @@
 package matrix
 
 import (
+	"encoding/json"
 	"fmt"
 	"go.uber.org/goleak"
 	"reflect"
+	"strings"
 	"testing"
 	"webApp/lib/eval"
+	v "webApp/lib/variables"
 )
 
 func TestTyping(t *testing.T) {
@@ -28,3 +31,253 @@ func TestAggregateRatings(t *testing.T) {
 		fmt.Println(err)
 	}
 }
+
+func TestAggregateNeutrosophic(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	first, second := NewMatrix(1, 1), NewMatrix(1, 1)
+	_ = first.SetValue(eval.NewNS(0.6, 0.3, 0.2), 0, 0)
+	_ = second.SetValue(eval.NewNS(0.8, 0.1, 0.1), 0, 0)
+
+	result, err := AggregateRatings([]Matrix{*first, *second}, []eval.Evaluated{eval.Number(0.3), eval.Number(0.7)}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := eval.NewNS(0.754, 0.139, 0.123)
+	fmt.Println(result.Data[0].Grade[0])
+	if !result.Data[0].Grade[0].Equals(want) {
+		t.Errorf("got %s, want %s", result.Data[0].Grade[0].String(), want.String())
+	}
+}
+
+func TestHesitance(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	m := NewMatrix(2, 1)
+	_ = m.SetValue(eval.NewHFS(0.1, 0.2, 0.9), 0, 0)
+	_ = m.SetValue(eval.NewHFS(0.3, 0.5), 1, 0)
+
+	for _, test := range []struct {
+		optimistic bool
+		want       eval.Number
+	}{
+		{optimistic: false, want: 0.233},
+		{optimistic: true, want: 0.3},
+	} {
+		m.SetHesitance(test.optimistic)
+		c := CopyMatrix(m)
+		d, err := c.Data[0].Grade[0].DiffNumber(c.Data[1].Grade[0], v.HammingDistance)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fmt.Println(d)
+		if !d.Equals(test.want) {
+			t.Errorf("optimistic %t: got %s, want %s", test.optimistic, d.String(), test.want.String())
+		}
+	}
+}
+
+func TestAlternativesReliability(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	first, second := NewMatrix(2, 1), NewMatrix(2, 1)
+	_ = first.SetValue(eval.NewZNumber(eval.NewT1FS(3, 5, 7), eval.NewT1FS(0.6, 0.8, 1)), 0, 0)
+	_ = first.SetValue(eval.Number(4), 1, 0)
+	_ = second.SetValue(eval.NewZNumber(eval.NewT1FS(4, 5, 6), eval.NewT1FS(0.2, 0.4, 0.6)), 0, 0)
+	_ = second.SetValue(eval.NewZNumber(eval.NewT1FS(2, 3, 4, 5), eval.NewT1FS(0.5, 0.6, 0.8, 0.9)), 1, 0)
+
+	result := AlternativesReliability([]Matrix{*first, *second})
+	if result == nil {
+		t.Fatal("reliability of Z-numbers is missing")
+	}
+
+	want := Reliabilities{0.6, 0.85}
+	fmt.Println(*result)
+	for i := range want {
+		if !(*result)[i].Equals(want[i]) {
+			t.Errorf("got %s, want %s", (*result)[i].String(), want[i].String())
+		}
+	}
+
+	if err := TypingMatrices(1, *first, *second); err != nil {
+		t.Fatal(err)
+	}
+	if first.Data[0].Grade[0].GetType() != (&eval.T1FS{}).GetType() {
+		t.Errorf("got %s, want Z-number typed as %s", first.Data[0].Grade[0].GetType(), (&eval.T1FS{}).GetType())
+	}
+}
+
+func TestAggregateTerms(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	first, second := NewMatrix(1, 2), NewMatrix(1, 2)
+	_ = first.SetValue(eval.Linguistic{Mark: "Good", Rating: eval.Rating{Evaluated: eval.Number(6)}}, 0, 0)
+	_ = first.SetValue(eval.Number(4.5), 0, 1)
+	_ = second.SetValue(eval.Linguistic{Mark: "Excellent", Rating: eval.Rating{Evaluated: eval.Number(9)}}, 0, 0)
+	_ = second.SetValue(eval.Number(7.5), 0, 1)
+
+	result, err := AggregateTerms(eval.DefaultNumberScale, []Matrix{*first, *second},
+		[]eval.Evaluated{eval.Number(2), eval.Number(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []eval.TwoTuple{{Term: 2, Mark: "Good", Alpha: 0.333}, {Term: 2, Mark: "Good", Alpha: -0.167}}
+	for j := range want {
+		got := result.Ratings[0][j]
+		fmt.Println(got)
+		if got.Term != want[j].Term || got.Mark != want[j].Mark || !got.Alpha.Equals(want[j].Alpha) {
+			t.Errorf("got %s, want %s", got.String(), want[j].String())
+		}
+	}
+
+	owa, err := eval.DefaultNumberScale.OWA([]eval.TwoTuple{{Term: 0}, {Term: 3}, {Term: 1, Alpha: 0.4}},
+		[]eval.Number{0.5, 0.3, 0.2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owa.Mark != "Good" || !owa.Alpha.Equals(eval.Number(-0.08)) {
+		t.Errorf("got %s, want Good, -0.08", owa.String())
+	}
+
+	if err = result.SetCoeffs(eval.DefaultNumberScale, RankedList{Coeffs: []eval.Rating{{Evaluated: eval.Number(0.9)},
+		{Evaluated: eval.Number(0.2)}}, Order: []int{0, 1}}, true); err != nil {
+		t.Fatal(err)
+	}
+	if result.Coeffs[0].Mark != "Excellent" || !result.Coeffs[0].Alpha.Equals(eval.Number(-0.3)) ||
+		result.Coeffs[1].Mark != "Normal" || !result.Coeffs[1].Alpha.Equals(eval.Number(-0.4)) {
+		t.Errorf("got %v, want [Excellent, -0.30 Normal, -0.40]", result.Coeffs)
+	}
+
+	// Coefficients of VIKOR are better the smaller they are.
+	if err = result.SetCoeffs(eval.DefaultNumberScale, RankedList{Coeffs: []eval.Rating{{Evaluated: eval.Number(0.1)},
+		{Evaluated: eval.Number(0.3)}, {Evaluated: eval.Number(0.7)}}, Order: []int{2, 0, 1}}, false); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(result.Coeffs)
+	for i, mark := range []string{"Excellent", "Good", "Bad"} {
+		if result.Coeffs[i].Mark != mark || !result.Coeffs[i].Alpha.Equals(eval.Number(0)) {
+			t.Errorf("got %v, want [Excellent, 0.00 Good, 0.00 Bad, 0.00]", result.Coeffs)
+		}
+	}
+}
+
+func TestUnifyScale(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	coarse := &eval.LinguisticScale{
+		Ratings: []eval.Rating{{Evaluated: eval.Number(1)}, {Evaluated: eval.Number(5)}, {Evaluated: eval.Number(9)}},
+		Marks:   []string{"Low", "Medium", "High"},
+	}
+	fine := &eval.LinguisticScale{
+		Ratings: []eval.Rating{{Evaluated: eval.Number(1)}, {Evaluated: eval.Number(2)}, {Evaluated: eval.Number(3)},
+			{Evaluated: eval.Number(5)}, {Evaluated: eval.Number(6)}, {Evaluated: eval.Number(8)}, {Evaluated: eval.Number(9)}},
+		Marks: []string{"Very bad", "Bad", "Fair", "Medium", "Good", "Very good", "Perfect"},
+	}
+
+	if err := eval.CheckScales(eval.DefaultNumberScale, coarse, fine); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewMatrix(1, 3)
+	_ = m.SetValue(eval.Linguistic{Mark: "Medium", Rating: eval.Rating{Evaluated: eval.Number(5)}}, 0, 0)
+	_ = m.SetValue(eval.Linguistic{Mark: "High", Rating: eval.Rating{Evaluated: eval.Number(9)}}, 0, 1)
+	_ = m.SetValue(eval.Number(2), 0, 2)
+	if err := m.UnifyScale(coarse, eval.DefaultNumberScale); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []eval.Number{4.5, 9, 2}
+	for j := range want {
+		fmt.Println(m.Data[0].Grade[j])
+		if !m.Data[0].Grade[j].ConvertToNumber().Equals(want[j]) {
+			t.Errorf("got %s, want %s", m.Data[0].Grade[j].String(), want[j].String())
+		}
+	}
+	if tuple, err := eval.DefaultNumberScale.Express(m.Data[0].Grade[0]); err != nil || tuple.Mark != "Good" ||
+		!tuple.Alpha.Equals(eval.Number(-0.5)) {
+		t.Errorf("got %s, want Good, -0.50", tuple.String())
+	}
+
+	tuple, err := fine.Transform(eval.TwoTuple{Term: 4, Mark: "Good"}, eval.DefaultNumberScale)
+	if err != nil || tuple.Mark != "Good" || tuple.Alpha != 0 {
+		t.Errorf("got %s, want Good, 0.00", tuple.String())
+	}
+
+	narrow := &eval.LinguisticScale{Ratings: []eval.Rating{{Evaluated: eval.Number(2)}, {Evaluated: eval.Number(9)}},
+		Marks: []string{"Bad", "Good"}}
+	unordered := &eval.LinguisticScale{Ratings: []eval.Rating{{Evaluated: eval.Number(1)}, {Evaluated: eval.Number(6)},
+		{Evaluated: eval.Number(3)}, {Evaluated: eval.Number(9)}}, Marks: []string{"Bad", "Good", "Normal", "Excellent"}}
+	for _, scale := range []*eval.LinguisticScale{narrow, unordered} {
+		if err := eval.CheckScales(eval.DefaultNumberScale, scale); err == nil {
+			t.Errorf("scale %v must be rejected", scale.Marks)
+		}
+	}
+
+	for _, scale := range []*eval.LinguisticScale{eval.DefaultIntervalScale, eval.DefaultT1FSScale, eval.DefaultNSScale} {
+		if err := scale.Validate(); err != nil {
+			t.Errorf("default scale %v: %s", scale.Marks, err)
+		}
+	}
+}
+
+func TestResolveExpressions(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var tests = []struct {
+		scale *eval.LinguisticScale
+		text  string
+		want  eval.Evaluated
+	}{
+		{eval.DefaultT1FSScale, "at least Good", eval.NewT1FS(5, 6, 9, 10)},
+		{eval.DefaultT1FSScale, "between normal and  Good", eval.NewT1FS(3, 4, 6, 7)},
+		{eval.DefaultT1FSScale, "Normal", eval.NewT1FS(3, 4, 5)},
+		{eval.DefaultNumberScale, "at most Normal", eval.Interval{Start: 1, End: 3}},
+		{eval.DefaultNumberScale, "greater than Normal", eval.Interval{Start: 6, End: 9}},
+		{eval.DefaultNumberScale, "very Good", eval.Number(6)},
+		{eval.DefaultIntervalScale, "lower than Excellent", eval.Interval{Start: 1, End: 7}},
+	}
+
+	for _, tt := range tests {
+		var r eval.Rating
+		if err := json.Unmarshal([]byte(fmt.Sprintf(`{"mark": %q}`, tt.text)), &r); err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := tt.scale.Resolve(r)
+		if err != nil {
+			t.Errorf("%s: %s", tt.text, err)
+			continue
+		}
+
+		fmt.Println(result)
+		ling, ok := result.Evaluated.(eval.Linguistic)
+		if !ok || ling.Mark != strings.Join(strings.Fields(tt.text), " ") {
+			t.Errorf("%s: expression is not kept in %s", tt.text, result.String())
+		} else if !ling.Rating.Equals(tt.want) {
+			t.Errorf("%s: got %s, want %s", tt.text, ling.Rating.String(), tt.want.String())
+		}
+	}
+
+	hedged, err := eval.DefaultT1FSScale.Resolve(eval.Rating{Evaluated: eval.Linguistic{Mark: "very Good"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cut := hedged.Evaluated.(eval.Linguistic).Rating.ConvertToT1FS(v.Default).MemberFunction(0.25)
+	if !cut.Equals(eval.Interval{Start: 5.5, End: 6.5}) {
+		t.Errorf("got %s, want [5.5, 6.5]", cut.String())
+	}
+
+	for _, text := range []string{"lower than Bad", "between Good and Normal", "fantastic", "at least"} {
+		if _, err := eval.DefaultNumberScale.Resolve(eval.Rating{Evaluated: eval.Linguistic{Mark: text}}); err == nil {
+			t.Errorf("expression %q must be rejected", text)
+		}
+	}
+
+	rated := eval.Rating{Evaluated: eval.Linguistic{Mark: "Medium", Rating: eval.Rating{Evaluated: eval.Number(5)}}}
+	if result, err := eval.DefaultNumberScale.Resolve(rated); err != nil || !result.Equals(rated) {
+		t.Errorf("got %s, want rating of a foreign term kept", result.String())
+	}
+}
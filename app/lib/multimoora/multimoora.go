@@ -0,0 +1,192 @@
+package multimoora
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const minimalValue = 1e-9
+
+func (mm *MultimooraMatrix) VectorNormalization() {
+	for j := 0; j < mm.CountCriteria; j++ {
+		norm := 0.
+		for i := range mm.Data {
+			x := float64(eval.Crisp(mm.Data[i].Grade[j], mm.Defuzzification))
+			norm += x * x
+		}
+		norm = math.Sqrt(norm)
+
+		for i := range mm.Data {
+			if norm == 0 {
+				mm.Normalized[i][j] = 0
+			} else {
+				mm.Normalized[i][j] = eval.Crisp(mm.Data[i].Grade[j], mm.Defuzzification) / eval.Number(norm)
+			}
+		}
+	}
+	mm.NormalizedFind = true
+}
+
+func (mm *MultimooraMatrix) CalcIndexes(g int) {
+	var wg sync.WaitGroup
+
+	weights := make([]eval.Number, mm.CountCriteria)
+	reference := make([]eval.Number, mm.CountCriteria)
+	for j, c := range mm.Criteria {
+		weights[j] = eval.Crisp(c.Weight, mm.Defuzzification)
+		for i := range mm.Normalized {
+			x := weights[j] * mm.Normalized[i][j]
+			if i == 0 || (c.TypeOfCriteria == v.Benefit && x > reference[j]) || (c.TypeOfCriteria == v.Cost && x < reference[j]) {
+				reference[j] = x
+			}
+		}
+	}
+
+	if g > mm.CountAlternatives {
+		g = mm.CountAlternatives
+	}
+	off := mm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = mm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				ratio, distance := eval.Number(0), eval.Number(0)
+				benefit, cost := 1., 1.
+				for j, c := range mm.Criteria {
+					x := mm.Normalized[i][j]
+					pow := math.Pow(math.Max(float64(x), minimalValue), float64(weights[j]))
+					if c.TypeOfCriteria == v.Benefit {
+						ratio += weights[j] * x
+						benefit *= pow
+					} else {
+						ratio -= weights[j] * x
+						cost *= pow
+					}
+					distance = max(distance, eval.Number(math.Abs(float64(reference[j]-weights[j]*x))))
+				}
+
+				mm.RatioSystem[i] = ratio
+				mm.ReferencePoint[i] = distance
+				mm.Multiplicative[i] = eval.Number(benefit / cost)
+			}
+		}(b)
+	}
+	wg.Wait()
+	mm.IndexesFind = true
+}
+
+func rank(values []eval.Number, descending bool) matrix.RankedList {
+	ind := make([]int, len(values))
+	for i := range ind {
+		ind[i] = i
+	}
+
+	sort.SliceStable(ind, func(i, j int) bool {
+		if descending {
+			return values[ind[i]] > values[ind[j]]
+		}
+		return values[ind[i]] < values[ind[j]]
+	})
+
+	set := make([]eval.Rating, len(values))
+	for i := range ind {
+		set[i] = eval.Rating{Evaluated: values[ind[i]]}
+	}
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
+
+func (mm *MultimooraMatrix) SubRankings() matrix.RankedLists {
+	return matrix.RankedLists{
+		rank(mm.RatioSystem, true),
+		rank(mm.ReferencePoint, false),
+		rank(mm.Multiplicative, true),
+	}
+}
+
+// places returns the place of every alternative in each sub-ranking,
+// alternatives with equal indexes sharing a place.
+func places(subRankings matrix.RankedLists) [][]int {
+	result := make([][]int, len(subRankings))
+	for k, r := range subRankings {
+		result[k] = make([]int, len(r.Order))
+		for place, alt := range r.Order {
+			if place > 0 && r.Coeffs[place].Equals(r.Coeffs[place-1].Evaluated) {
+				result[k][alt] = result[k][r.Order[place-1]]
+			} else {
+				result[k][alt] = place
+			}
+		}
+	}
+	return result
+}
+
+// dominanceRanking ranks alternatives by the dominance theory of Brauers and
+// Zavadskas from their places in the sub-rankings. An alternative dominates
+// another placed higher by it in at least two of three sub-rankings (general
+// dominance, overall if in all of them, absolute if it dominates every other
+// alternative this way). Dominance is transitive; alternatives dominating
+// each other through a cycle (circular reasoning) and alternatives neither
+// of which dominates the other (equability) share a rank. Coeffs hold the
+// number of alternatives dominated by the alternative and not back.
+func dominanceRanking(places [][]int) matrix.RankedList {
+	n := len(places[0])
+	reach := make([][]bool, n)
+	for a := range reach {
+		reach[a] = make([]bool, n)
+		for b := range reach[a] {
+			wins := 0
+			for k := range places {
+				if places[k][a] < places[k][b] {
+					wins++
+				}
+			}
+			reach[a][b] = a != b && wins*3 >= len(places)*2
+		}
+	}
+
+	for c := 0; c < n; c++ {
+		for a := 0; a < n; a++ {
+			for b := 0; b < n; b++ {
+				reach[a][b] = reach[a][b] || (reach[a][c] && reach[c][b])
+			}
+		}
+	}
+
+	scores := make([]eval.Number, n)
+	for a := 0; a < n; a++ {
+		for b := 0; b < n; b++ {
+			if reach[a][b] && !reach[b][a] {
+				scores[a]++
+			}
+		}
+	}
+	return rank(scores, true)
+}
+
+// RankedList fuses the three sub-rankings either by dominance theory or by
+// summing Borda points.
+func (mm *MultimooraMatrix) RankedList(fusion v.Variants) matrix.RankedList {
+	subRankings := mm.SubRankings()
+	if fusion == v.BordaFusion {
+		borda := make([]eval.Number, mm.CountAlternatives)
+		for _, r := range subRankings {
+			for place, alt := range r.Order {
+				borda[alt] += eval.Number(mm.CountAlternatives - place - 1)
+			}
+		}
+		return rank(borda, true)
+	}
+	return dominanceRanking(places(subRankings))
+}
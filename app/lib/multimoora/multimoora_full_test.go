@@ -0,0 +1,118 @@
+package multimoora
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func MultimooraCalculating(multimooraMatrix *MultimooraMatrix, weightNorm, fusion v.Variants) (matrix.RankedList, error) {
+	if err := multimooraMatrix.NormalizationWeights(weightNorm); err != nil {
+		return matrix.RankedList{}, err
+	}
+
+	multimooraMatrix.VectorNormalization()
+
+	multimooraMatrix.CalcIndexes(5)
+
+	return multimooraMatrix.RankedList(fusion), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *MultimooraMatrix
+		weightNorm v.Variants
+		fusion     v.Variants
+		resultRow  []int
+	}{
+		{
+			initMat:    ConvertToMultimooraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			fusion:     v.DominanceFusion,
+			resultRow:  []int{0, 1, 2, 3},
+		},
+		{
+			initMat:    ConvertToMultimooraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			fusion:     v.BordaFusion,
+			resultRow:  []int{0, 1, 2, 3},
+		},
+		{
+			initMat:    ConvertToMultimooraMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			fusion:     v.DominanceFusion,
+			resultRow:  []int{0, 1, 2},
+		},
+		{
+			initMat:    ConvertToMultimooraMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			fusion:     v.BordaFusion,
+			resultRow:  []int{3, 2, 1, 0},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := MultimooraCalculating(tt.initMat, tt.weightNorm, tt.fusion); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.SubRankings(), res)
+				for i, el := range res.Order {
+					if el != tt.resultRow[i] {
+						t.Errorf("got %d, want %d\n", el, tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDominanceTheory(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var tests = []struct {
+		places [][]int
+		order  []int
+		coeffs []eval.Number
+	}{
+		{
+			// circular reasoning of Brauers and Zavadskas: objects A (11-20-14),
+			// B (14-16-15) and C (15-19-12) dominate each other in a cycle
+			places: [][]int{{11, 14, 15}, {20, 16, 19}, {14, 15, 12}},
+			order:  []int{0, 1, 2},
+			coeffs: []eval.Number{0, 0, 0},
+		},
+		{
+			// the cycle above, an absolutely dominating D and a D-E-E object
+			// overall dominated by D and generally dominating the cycle
+			places: [][]int{{11, 14, 15, 1, 2}, {20, 16, 19, 1, 2}, {14, 15, 12, 1, 30}},
+			order:  []int{3, 4, 0, 1, 2},
+			coeffs: []eval.Number{4, 3, 0, 0, 0},
+		},
+		{
+			// absolute equability of the first two alternatives
+			places: [][]int{{0, 0, 2}, {1, 1, 0}, {0, 0, 1}},
+			order:  []int{0, 1, 2},
+			coeffs: []eval.Number{1, 1, 0},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d test", i), func(t *testing.T) {
+			res := dominanceRanking(tt.places)
+			fmt.Println(res)
+			for j := range tt.order {
+				if res.Order[j] != tt.order[j] || !res.Coeffs[j].Equals(tt.coeffs[j]) {
+					t.Errorf("got %d with %s, want %d with %s", res.Order[j], res.Coeffs[j].String(), tt.order[j], tt.coeffs[j].String())
+				}
+			}
+		})
+	}
+}
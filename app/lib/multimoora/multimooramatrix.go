@@ -0,0 +1,95 @@
+package multimoora
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type MultimooraMatrix struct {
+	*matrix.Matrix
+	Normalized     [][]eval.Number `json:"normalized"`
+	NormalizedFind bool            `json:"is_normalized_find"`
+	RatioSystem    []eval.Number   `json:"ratio_system"`
+	ReferencePoint []eval.Number   `json:"reference_point"`
+	Multiplicative []eval.Number   `json:"multiplicative"`
+	IndexesFind    bool            `json:"is_indexes_find"`
+}
+
+func NewMultimooraMatrix(x, y int) *MultimooraMatrix {
+	return &MultimooraMatrix{
+		Matrix:         matrix.NewMatrix(x, y),
+		Normalized:     newNormalized(x, y),
+		RatioSystem:    make([]eval.Number, x),
+		ReferencePoint: make([]eval.Number, x),
+		Multiplicative: make([]eval.Number, x),
+	}
+}
+
+func ConvertToMultimooraMatrix(m *matrix.Matrix) *MultimooraMatrix {
+	return &MultimooraMatrix{
+		Matrix:         matrix.CopyMatrix(m),
+		Normalized:     newNormalized(m.CountAlternatives, m.CountCriteria),
+		RatioSystem:    make([]eval.Number, m.CountAlternatives),
+		ReferencePoint: make([]eval.Number, m.CountAlternatives),
+		Multiplicative: make([]eval.Number, m.CountAlternatives),
+	}
+}
+
+func newNormalized(x, y int) [][]eval.Number {
+	normalized := make([][]eval.Number, x)
+	for i := range normalized {
+		normalized[i] = make([]eval.Number, y)
+	}
+	return normalized
+}
+
+func (mm *MultimooraMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range mm.Data {
+		s += mm.Data[i].String() + "\n"
+	}
+
+	if mm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < mm.CountCriteria; i++ {
+			s += mm.Criteria[i].String() + " "
+		}
+	}
+
+	if mm.IndexesFind {
+		s += "\nRatio system:\n"
+		for i := 0; i < mm.CountAlternatives; i++ {
+			s += mm.RatioSystem[i].String() + " "
+		}
+		s += "\nReference point:\n"
+		for i := 0; i < mm.CountAlternatives; i++ {
+			s += mm.ReferencePoint[i].String() + " "
+		}
+		s += "\nFull multiplicative form:\n"
+		for i := 0; i < mm.CountAlternatives; i++ {
+			s += mm.Multiplicative[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateIndexes(matrices []MultimooraMatrix, weights []eval.Evaluated) (*MultimooraMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewMultimooraMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i := 0; i < x; i++ {
+			result.RatioSystem[i] += matrices[k].RatioSystem[i] * weights[k].ConvertToNumber()
+			result.ReferencePoint[i] += matrices[k].ReferencePoint[i] * weights[k].ConvertToNumber()
+			result.Multiplicative[i] += matrices[k].Multiplicative[i] * weights[k].ConvertToNumber()
+		}
+	}
+	result.IndexesFind = true
+	return result, nil
+}
@@ -0,0 +1,152 @@
+package promethee
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func preference(t matrix.Thresholds, d float64) float64 {
+	switch t.Shape {
+	case v.UShapeCriterion:
+		if d > t.Indifference {
+			return 1
+		}
+		return 0
+	case v.VShapeCriterion:
+		if d <= 0 {
+			return 0
+		} else if d < t.Preference {
+			return d / t.Preference
+		}
+		return 1
+	case v.LevelCriterion:
+		if d <= t.Indifference {
+			return 0
+		} else if d <= t.Preference {
+			return 0.5
+		}
+		return 1
+	case v.LinearCriterion:
+		if d <= t.Indifference {
+			return 0
+		} else if d <= t.Preference {
+			return (d - t.Indifference) / (t.Preference - t.Indifference)
+		}
+		return 1
+	case v.GaussianCriterion:
+		if d <= 0 || t.Gaussian == 0 {
+			return 0
+		}
+		return 1 - math.Exp(-d*d/(2*t.Gaussian*t.Gaussian))
+	default:
+		if d > 0 {
+			return 1
+		}
+		return 0
+	}
+}
+
+func (pm *PrometheeMatrix) CalcPreferences(g int) {
+	var wg sync.WaitGroup
+	if g > pm.CountAlternatives {
+		g = pm.CountAlternatives
+	}
+	off := pm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = pm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				for k := range pm.Data {
+					pm.Preferences[i][k] = 0
+					if i == k {
+						continue
+					}
+
+					for j, c := range pm.Criteria {
+						d := float64(eval.Crisp(pm.Data[i].Grade[j], pm.Defuzzification) - eval.Crisp(pm.Data[k].Grade[j], pm.Defuzzification))
+						if c.TypeOfCriteria == v.Cost {
+							d = -d
+						}
+						pm.Preferences[i][k] += eval.Crisp(c.Weight, pm.Defuzzification) * eval.Number(preference(c.Thresholds, d))
+					}
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+	pm.PreferencesFind = true
+}
+
+func (pm *PrometheeMatrix) CalcFlows() {
+	n := eval.Number(pm.CountAlternatives - 1)
+	if n == 0 {
+		n = 1
+	}
+
+	for i := range pm.Data {
+		positive, negative := eval.Number(0), eval.Number(0)
+		for k := range pm.Data {
+			positive += pm.Preferences[i][k]
+			negative += pm.Preferences[k][i]
+		}
+		pm.PositiveFlows[i].Evaluated = positive / n
+		pm.NegativeFlows[i].Evaluated = negative / n
+		pm.NetFlows[i].Evaluated = (positive - negative) / n
+	}
+	pm.FlowsFind = true
+}
+
+func (pm *PrometheeMatrix) PartialOrder() matrix.PartialOrder {
+	order := matrix.NewPartialOrder(pm.CountAlternatives)
+
+	for a := range pm.Data {
+		for b := range pm.Data {
+			if a == b {
+				continue
+			}
+
+			posA, posB := pm.PositiveFlows[a].ConvertToNumber(), pm.PositiveFlows[b].ConvertToNumber()
+			negA, negB := pm.NegativeFlows[a].ConvertToNumber(), pm.NegativeFlows[b].ConvertToNumber()
+
+			if posA.Equals(posB) && negA.Equals(negB) {
+				order.Relations[a][b] = v.Indifference
+			} else if (posA > posB || posA.Equals(posB)) && (negA < negB || negA.Equals(negB)) {
+				order.Relations[a][b] = v.Preference
+			} else if (posB > posA || posA.Equals(posB)) && (negB < negA || negA.Equals(negB)) {
+				order.Relations[a][b] = v.InversePreference
+			} else {
+				order.Relations[a][b] = v.Incomparability
+			}
+		}
+	}
+	return order
+}
+
+func (pm *PrometheeMatrix) RankedList(_ v.Variants) matrix.RankedList {
+	set := make([]eval.Rating, len(pm.NetFlows))
+	ind := make([]int, len(pm.NetFlows))
+	for i := range set {
+		ind[i] = i
+		set[i] = pm.NetFlows[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], pm.Defuzzification) > eval.Crisp(set[ind[j]], pm.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], pm.Defuzzification) > eval.Crisp(set[j], pm.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
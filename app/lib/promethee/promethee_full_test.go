@@ -0,0 +1,116 @@
+package promethee
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func PrometheeCalculating(prometheeMatrix *PrometheeMatrix, thresholds matrix.Thresholds, weightNorm v.Variants) ([]eval.Rating, error) {
+	for j := 0; j < prometheeMatrix.CountCriteria; j++ {
+		if err := prometheeMatrix.SetThresholds(thresholds, j); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := prometheeMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, err
+	}
+
+	prometheeMatrix.CalcPreferences(5)
+
+	prometheeMatrix.CalcFlows()
+
+	return prometheeMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *PrometheeMatrix
+		thresholds matrix.Thresholds
+		weightNorm v.Variants
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToPrometheeMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			thresholds: matrix.Thresholds{Shape: v.UsualCriterion},
+			weightNorm: v.NormalizeWithSum,
+			resultRow:  []eval.Number{0.210, 0.348, -0.097, -0.461},
+		},
+		{
+			initMat:    ConvertToPrometheeMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			thresholds: matrix.Thresholds{Shape: v.LinearCriterion, Indifference: 0.5, Preference: 3},
+			weightNorm: v.NormalizeWithSum,
+			resultRow:  []eval.Number{0.386, 0.277, -0.395, -0.268},
+		},
+		{
+			initMat:    ConvertToPrometheeMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			thresholds: matrix.Thresholds{Shape: v.VShapeCriterion, Preference: 4},
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{0.049, 0.101, -0.150},
+		},
+		{
+			initMat:    ConvertToPrometheeMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			thresholds: matrix.Thresholds{Shape: v.GaussianCriterion, Gaussian: 2},
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow:  []eval.Number{-0.222, -0.202, 0.267, 0.158},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := PrometheeCalculating(tt.initMat, tt.thresholds, tt.weightNorm); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList(v.Default), tt.initMat.PartialOrder())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDefuzzificationStrategy(t *testing.T) {
+	var tests = []struct {
+		method v.Variants
+		order  []int
+	}{
+		{method: v.Centroid, order: []int{0, 1}},
+		{method: v.GradedMeanIntegration, order: []int{1, 0}},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			m := NewPrometheeMatrix(2, 2)
+			_ = m.SetValue(eval.Number(1), 0, 0)
+			_ = m.SetValue(eval.Number(0), 0, 1)
+			_ = m.SetValue(eval.Number(0), 1, 0)
+			_ = m.SetValue(eval.Number(1), 1, 1)
+			_ = m.SetCriterion(eval.NewT1FS(0, 1, 10), v.Benefit, 0)
+			_ = m.SetCriterion(eval.NewT1FS(2, 3, 4), v.Benefit, 1)
+			for j := 0; j < m.CountCriteria; j++ {
+				_ = m.SetThresholds(matrix.Thresholds{Shape: v.UShapeCriterion}, j)
+			}
+			m.Defuzzification = tt.method
+
+			m.CalcPreferences(1)
+			m.CalcFlows()
+			if list := m.RankedList(v.Default); !reflect.DeepEqual(list.Order, tt.order) {
+				t.Errorf("got %v, want %v", list.Order, tt.order)
+			}
+		})
+	}
+}
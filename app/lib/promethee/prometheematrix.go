@@ -0,0 +1,116 @@
+package promethee
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type PrometheeMatrix struct {
+	*matrix.Matrix
+	Preferences     [][]eval.Number `json:"preferences"`
+	PreferencesFind bool            `json:"is_pref_find"`
+	PositiveFlows   []eval.Rating   `json:"pos_flows"`
+	NegativeFlows   []eval.Rating   `json:"neg_flows"`
+	NetFlows        []eval.Rating   `json:"net_flows"`
+	FlowsFind       bool            `json:"is_flows_find"`
+}
+
+func NewPrometheeMatrix(x, y int) *PrometheeMatrix {
+	return &PrometheeMatrix{
+		Matrix:        matrix.NewMatrix(x, y),
+		Preferences:   newPreferences(x),
+		PositiveFlows: make([]eval.Rating, x),
+		NegativeFlows: make([]eval.Rating, x),
+		NetFlows:      make([]eval.Rating, x),
+	}
+}
+
+func ConvertToPrometheeMatrix(m *matrix.Matrix) *PrometheeMatrix {
+	return &PrometheeMatrix{
+		Matrix:        matrix.CopyMatrix(m),
+		Preferences:   newPreferences(m.CountAlternatives),
+		PositiveFlows: make([]eval.Rating, m.CountAlternatives),
+		NegativeFlows: make([]eval.Rating, m.CountAlternatives),
+		NetFlows:      make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func newPreferences(size int) [][]eval.Number {
+	preferences := make([][]eval.Number, size)
+	for i := range preferences {
+		preferences[i] = make([]eval.Number, size)
+	}
+	return preferences
+}
+
+func (pm *PrometheeMatrix) GetCoefs() []eval.Rating {
+	return pm.NetFlows
+}
+
+func (pm *PrometheeMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range pm.Data {
+		s += pm.Data[i].String() + "\n"
+	}
+
+	if pm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < pm.CountCriteria; i++ {
+			s += pm.Criteria[i].String() + " "
+		}
+	}
+
+	if pm.PreferencesFind {
+		s += "\nPreference indices:\n"
+		for i := range pm.Preferences {
+			s += "[ "
+			for j := range pm.Preferences[i] {
+				s += pm.Preferences[i][j].String() + " "
+			}
+			s += " ]\n"
+		}
+	}
+
+	if pm.FlowsFind {
+		s += "\nPositive flows:\n"
+		for i := 0; i < pm.CountAlternatives; i++ {
+			s += pm.PositiveFlows[i].String() + " "
+		}
+		s += "\nNegative flows:\n"
+		for i := 0; i < pm.CountAlternatives; i++ {
+			s += pm.NegativeFlows[i].String() + " "
+		}
+		s += "\nNet flows:\n"
+		for i := 0; i < pm.CountAlternatives; i++ {
+			s += pm.NetFlows[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateFlows(matrices []PrometheeMatrix, weights []eval.Evaluated) (*PrometheeMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewPrometheeMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i := range matrices[k].NetFlows {
+			if k == 0 {
+				result.PositiveFlows[i] = matrices[k].PositiveFlows[i].Weighted(weights[k])
+				result.NegativeFlows[i] = matrices[k].NegativeFlows[i].Weighted(weights[k])
+				result.NetFlows[i] = matrices[k].NetFlows[i].Weighted(weights[k])
+				continue
+			}
+			result.PositiveFlows[i] = result.PositiveFlows[i].Sum(matrices[k].PositiveFlows[i].Weighted(weights[k]))
+			result.NegativeFlows[i] = result.NegativeFlows[i].Sum(matrices[k].NegativeFlows[i].Weighted(weights[k]))
+			result.NetFlows[i] = result.NetFlows[i].Sum(matrices[k].NetFlows[i].Weighted(weights[k]))
+		}
+	}
+	result.FlowsFind = true
+	return result, nil
+}
@@ -1,6 +1,7 @@
 package smart
 
 import (
+	"math"
 	"sort"
 	"sync"
 	"webApp/lib/eval"
@@ -8,6 +9,8 @@ import (
 	v "webApp/lib/variables"
 )
 
+const DefaultWaspasLambda = 0.5
+
 func (sm *SmartMatrix) CalcFinalScore(g int) {
 	var wg sync.WaitGroup
 	if g > sm.CountAlternatives {
@@ -33,6 +36,95 @@ func (sm *SmartMatrix) CalcFinalScore(g int) {
 	wg.Wait()
 }
 
+// CalcProductScore computes the weighted product model on the ratings of raw,
+// the matrix as it was before value normalization. Benefit ratings are raised
+// to the criterion weight and cost ratings to its negative, then every column
+// is scaled by its largest value, which yields the WASPAS ratios x/max and
+// min/x regardless of the normalization applied to the additive part.
+func (sm *SmartMatrix) CalcProductScore(raw *matrix.Matrix, g int) error {
+	var wg sync.WaitGroup
+	var err error
+	if g > sm.CountAlternatives {
+		g = sm.CountAlternatives
+	}
+	off := sm.CountAlternatives / g
+	powered := make([][]eval.Rating, sm.CountAlternatives)
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = sm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				powered[i] = make([]eval.Rating, sm.CountCriteria)
+				for j, c := range sm.Criteria {
+					exponent := eval.Crisp(c.Weight, sm.Defuzzification)
+					if c.TypeOfCriteria == v.Cost {
+						exponent = -exponent
+					}
+
+					var inerr error
+					if powered[i][j], inerr = eval.Power(raw.Data[i].Grade[j], exponent); inerr != nil {
+						err = inerr
+						return
+					}
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	for j := range sm.Criteria {
+		best := eval.Number(0)
+		for i := range powered {
+			best = max(best, upperBound(powered[i][j]))
+		}
+
+		if best == 0 || math.IsInf(float64(best), 1) {
+			return v.EmptyValues
+		}
+
+		for i := range powered {
+			p := powered[i][j].Weighted(1 / best)
+			if j == 0 {
+				sm.ProductScores[i] = p
+				continue
+			}
+
+			if sm.ProductScores[i], err = eval.Product(sm.ProductScores[i], p); err != nil {
+				return err
+			}
+		}
+	}
+
+	sm.ProductScoresFind = true
+	return nil
+}
+
+func upperBound(r eval.Rating) eval.Number {
+	if r.GetType() == (&eval.T1FS{}).GetType() {
+		vert := r.ConvertToT1FS(v.Default).Vert
+		return vert[len(vert)-1]
+	}
+	return r.ConvertToInterval().End
+}
+
+func (sm *SmartMatrix) CalcWaspasScore(lambda float64) {
+	for i := range sm.FinalScores {
+		sm.FinalScores[i] = sm.FinalScores[i].Weighted(eval.Number(lambda)).
+			Sum(sm.ProductScores[i].Weighted(eval.Number(1 - lambda)))
+	}
+	sm.FinalScoresFind = true
+}
+
 func (sm *SmartMatrix) RankedList(ranking v.Variants) matrix.RankedList {
 	set := make([]eval.Rating, len(sm.FinalScores))
 	ind := make([]int, len(sm.FinalScores))
@@ -53,12 +145,23 @@ func (sm *SmartMatrix) RankedList(ranking v.Variants) matrix.RankedList {
 			r := set[j].ConvertToInterval()
 			return l.SenguptaGeq(r)
 		})
+	} else if ranking == v.GreyPossibility {
+		sort.Slice(ind, func(i, j int) bool {
+			l := set[ind[i]].ConvertToGrey()
+			r := set[ind[j]].ConvertToGrey()
+			return l.PossibilityGeq(r)
+		})
+		sort.Slice(set, func(i, j int) bool {
+			l := set[i].ConvertToGrey()
+			r := set[j].ConvertToGrey()
+			return l.PossibilityGeq(r)
+		})
 	} else {
 		sort.Slice(ind, func(i, j int) bool {
-			return set[ind[i]].ConvertToNumber() > set[ind[j]].ConvertToNumber()
+			return eval.Crisp(set[ind[i]], sm.Defuzzification) > eval.Crisp(set[ind[j]], sm.Defuzzification)
 		})
 		sort.Slice(set, func(i, j int) bool {
-			return set[i].ConvertToNumber() > set[j].ConvertToNumber()
+			return eval.Crisp(set[i], sm.Defuzzification) > eval.Crisp(set[j], sm.Defuzzification)
 		})
 	}
 	return matrix.RankedList{Coeffs: set, Order: ind}
@@ -70,6 +70,28 @@ func TestTableDriven(t *testing.T) {
 				eval.NewAIFS(0.801, []eval.Number{0.220, 0.412, 0.442, 0.967}...),
 			},
 		},
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.PFS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			resultRow: []eval.Evaluated{
+				eval.NewPFS(1, 0, []eval.Number{0.241, 0.310, 0.649, 0.683}...),
+				eval.NewPFS(1, 0, []eval.Number{0.243, 0.405, 0.405, 0.590}...),
+				eval.NewPFS(0.083, 0.701, []eval.Number{0.533, 0.585, 0.666, 0.751}...),
+				eval.NewPFS(0.931, 0.074, []eval.Number{0.507, 0.521, 0.579, 0.623}...),
+			},
+		},
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.HFS{}), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			resultRow: []eval.Evaluated{
+				eval.NewHFS(0.282, 0.361, 0.830),
+				eval.NewHFS(0.447, 0.616, 0.862),
+				eval.NewHFS(0.254, 0.350, 0.643),
+				eval.NewHFS(0.131, 0.167),
+			},
+		},
 		{
 			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.IT2FS{}), reflect.TypeOf(eval.Interval{}), 108)),
 			valueNorm:  v.NormalizeWithSum,
@@ -102,3 +124,197 @@ func TestTableDriven(t *testing.T) {
 		})
 	}
 }
+
+func WaspasCalculating(smartMatrix *SmartMatrix, normValue, NormWeights v.Variants, lambda float64) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(5, *smartMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	raw := matrix.CopyMatrix(smartMatrix.Matrix)
+	if err := smartMatrix.Normalization(normValue, NormWeights, 5); err != nil {
+		return nil, err
+	}
+
+	if err := smartMatrix.CalcProductScore(raw, 5); err != nil {
+		return nil, err
+	}
+
+	smartMatrix.CalcWeightedMatrix(3)
+
+	smartMatrix.CalcFinalScore(5)
+
+	smartMatrix.CalcWaspasScore(lambda)
+
+	return smartMatrix.GetScores(), nil
+}
+
+func TestWaspasTableDriven(t *testing.T) {
+	var testCases = []struct {
+		initMat    *SmartMatrix
+		valueNorm  v.Variants
+		weightNorm v.Variants
+		lambda     float64
+		resultRow  []eval.Evaluated
+	}{
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			lambda:     0,
+			resultRow:  []eval.Evaluated{eval.Number(0.735), eval.Number(0.648), eval.Number(0.409), eval.Number(0.402)},
+		},
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			lambda:     DefaultWaspasLambda,
+			resultRow:  []eval.Evaluated{eval.Number(0.505), eval.Number(0.556), eval.Number(0.451), eval.Number(0.513)},
+		},
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			lambda:     DefaultWaspasLambda,
+			resultRow: []eval.Evaluated{
+				eval.Interval{Start: 0.482, End: 0.548},
+				eval.Interval{Start: 0.364, End: 0.394},
+				eval.Interval{Start: 0.199, End: 0.538}},
+		},
+		{
+			initMat:    ConvertToSmartMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			lambda:     DefaultWaspasLambda,
+			resultRow: []eval.Evaluated{
+				eval.NewT1FS([]eval.Number{0.170, 0.182, 0.251, 0.276}...),
+				eval.NewT1FS([]eval.Number{0.205, 0.273, 0.273, 0.333}...),
+				eval.NewT1FS([]eval.Number{0.149, 0.176, 0.496, 0.578}...),
+				eval.NewT1FS([]eval.Number{0.235, 0.261, 0.354, 0.407}...),
+			},
+		},
+	}
+
+	for i, tt := range testCases {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := WaspasCalculating(tt.initMat, tt.valueNorm, tt.weightNorm, tt.lambda); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList(v.Default))
+				for i, el := range res {
+					if !el.Equals(tt.resultRow[i]) {
+						t.Errorf("got %s, want %s\n", el.String(), tt.resultRow[i].String())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWaspasCostCriterion(t *testing.T) {
+	var testCases = []struct {
+		valueNorm v.Variants
+		resultRow []eval.Evaluated
+		order     []int
+	}{
+		{
+			valueNorm: v.NormalizeWithSum,
+			resultRow: []eval.Evaluated{eval.Number(0.707), eval.Number(0.224), eval.Number(0.333)},
+			order:     []int{0, 2, 1},
+		},
+		{
+			valueNorm: v.NormalizeValueWithMax,
+			resultRow: []eval.Evaluated{eval.Number(0.707), eval.Number(0.224), eval.Number(0.333)},
+			order:     []int{0, 2, 1},
+		},
+	}
+
+	for i, tt := range testCases {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			sm := NewSmartMatrix(3, 2)
+			for k, row := range [][]eval.Number{{4, 1}, {2, 5}, {8, 9}} {
+				for j := range row {
+					_ = sm.SetValue(row[j], k, j)
+				}
+			}
+			_ = sm.SetCriterion(eval.Number(1), v.Benefit, 0)
+			_ = sm.SetCriterion(eval.Number(1), v.Cost, 1)
+
+			if res, err := WaspasCalculating(sm, tt.valueNorm, v.NormalizeWithSum, 0); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				for i, el := range res {
+					if !el.Equals(tt.resultRow[i]) {
+						t.Errorf("got %s, want %s\n", el.String(), tt.resultRow[i].String())
+					}
+				}
+
+				if list := sm.RankedList(v.Default); !reflect.DeepEqual(list.Order, tt.order) {
+					t.Errorf("got %v, want %v", list.Order, tt.order)
+				}
+			}
+		})
+	}
+}
+
+func TestDefuzzification(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var testCases = []struct {
+		method v.Variants
+		crisp  []eval.Number
+		order  []int
+	}{
+		{method: v.AlphaCutAverage, order: []int{1, 0}},
+		{method: v.Centroid, crisp: []eval.Number{3.667, 3}, order: []int{0, 1}},
+		{method: v.Bisector, crisp: []eval.Number{3.292, 3}, order: []int{0, 1}},
+		{method: v.MeanOfMaxima, crisp: []eval.Number{1, 3}, order: []int{1, 0}},
+		{method: v.GradedMeanIntegration, crisp: []eval.Number{2.333, 3}, order: []int{1, 0}},
+	}
+
+	for i, tt := range testCases {
+		t.Run(fmt.Sprintf("%d test", i), func(t *testing.T) {
+			sm := NewSmartMatrix(2, 1)
+			sm.FinalScores[0] = eval.Rating{Evaluated: eval.NewT1FS(0, 1, 10)}
+			sm.FinalScores[1] = eval.Rating{Evaluated: eval.NewT1FS(2, 3, 4)}
+			sm.Defuzzification = tt.method
+
+			for j := range tt.crisp {
+				if got := eval.Crisp(sm.FinalScores[j], tt.method); !got.Equals(tt.crisp[j]) {
+					t.Errorf("got %s, want %s", got.String(), tt.crisp[j].String())
+				}
+			}
+
+			list := sm.RankedList(v.Default)
+			fmt.Println(list)
+			if !reflect.DeepEqual(list.Order, tt.order) {
+				t.Errorf("got %v, want %v", list.Order, tt.order)
+			}
+		})
+	}
+
+	it2fs := eval.NewIT2FS([]eval.Interval{{Start: 0, End: 0}, {Start: 10, End: 10}}, []eval.Number{1})
+	if c := it2fs.Centroid(); !c.Equals(eval.Interval{Start: 3.667, End: 3.667}) {
+		t.Errorf("got %s, want centroid of the embedded set [3.667, 3.667]", c.String())
+	}
+
+	it2fs = eval.NewIT2FS([]eval.Interval{{Start: 0, End: 2}, {Start: 8, End: 10}}, []eval.Number{5})
+	if c := it2fs.Centroid(); c.Start >= 5 || c.End <= 5 || !eval.Crisp(it2fs, v.Centroid).Equals(eval.Number(5)) {
+		t.Errorf("got %s, want interval symmetric around 5", c.String())
+	}
+
+	it2fs = eval.NewIT2FS([]eval.Interval{{Start: 1, End: 4}, {Start: 6, End: 12}}, []eval.Number{5})
+	for _, algorithm := range []v.Variants{v.KarnikMendel, v.EnhancedKarnikMendel} {
+		if c := it2fs.TypeReduction(algorithm); !c.Equals(eval.Interval{Start: 3.941, End: 7.367}) {
+			t.Errorf("got %s, want [3.941, 7.367]", c.String())
+		}
+	}
+
+	if a := it2fs.ConvertToAIFS(v.Default); !a.Equals(eval.NewAIFS(0.818, 2.5, 5, 9)) {
+		t.Errorf("got %s, want footprint kept as hesitancy 0.818", a.String())
+	}
+}
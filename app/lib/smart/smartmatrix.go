@@ -8,8 +8,10 @@ import (
 
 type SmartMatrix struct {
 	*matrix.Matrix
-	FinalScores     []eval.Rating `json:"final_scores"`
-	FinalScoresFind bool          `json:"is_final_find"`
+	ProductScores     []eval.Rating `json:"product_scores"`
+	ProductScoresFind bool          `json:"is_product_find"`
+	FinalScores       []eval.Rating `json:"final_scores"`
+	FinalScoresFind   bool          `json:"is_final_find"`
 }
 
 func (sm *SmartMatrix) GetScores() []eval.Rating {
@@ -29,6 +31,13 @@ func (sm *SmartMatrix) String() string {
 		}
 	}
 
+	if sm.ProductScoresFind {
+		s += "\nProduct Scores:\n"
+		for i := 0; i < sm.CountAlternatives; i++ {
+			s += sm.ProductScores[i].String() + " "
+		}
+	}
+
 	if sm.FinalScoresFind {
 		s += "\nFinal Scores:\n"
 		for i := 0; i < sm.CountAlternatives; i++ {
@@ -41,8 +50,9 @@ func (sm *SmartMatrix) String() string {
 
 func NewSmartMatrix(x, y int) *SmartMatrix {
 	sm := SmartMatrix{
-		Matrix:      matrix.NewMatrix(x, y),
-		FinalScores: make([]eval.Rating, x),
+		Matrix:        matrix.NewMatrix(x, y),
+		ProductScores: make([]eval.Rating, x),
+		FinalScores:   make([]eval.Rating, x),
 	}
 
 	for i := range sm.Data {
@@ -54,6 +64,7 @@ func NewSmartMatrix(x, y int) *SmartMatrix {
 func ConvertToSmartMatrix(m *matrix.Matrix) *SmartMatrix {
 	return &SmartMatrix{
 		Matrix:          matrix.CopyMatrix(m),
+		ProductScores:   make([]eval.Rating, m.CountAlternatives),
 		FinalScores:     make([]eval.Rating, m.CountAlternatives),
 		FinalScoresFind: false,
 	}
@@ -62,6 +73,7 @@ func ConvertToSmartMatrix(m *matrix.Matrix) *SmartMatrix {
 func AggregateScores(matrices []SmartMatrix, weights []eval.Evaluated) (*SmartMatrix, error) {
 	x := matrices[0].CountAlternatives
 	result := NewSmartMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
 	for k := range matrices {
 		if x != matrices[k].CountAlternatives {
 			return nil, v.InvalidSize
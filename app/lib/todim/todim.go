@@ -0,0 +1,129 @@
+package todim
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const DefaultTheta = 1.0
+
+func (tm *TodimMatrix) CalcDominance(valueNorm v.Variants, theta float64, vn v.Variants, g int) error {
+	var wg sync.WaitGroup
+	var err error
+
+	if theta <= 0 {
+		return v.InvalidCaseOfOperation
+	}
+
+	weights := make([]eval.Number, tm.CountCriteria)
+	reference := eval.Number(0)
+	for j, c := range tm.Criteria {
+		weights[j] = eval.Crisp(c.Weight, tm.Defuzzification)
+		reference = max(reference, weights[j])
+	}
+	if reference == 0 {
+		return v.EmptyValues
+	}
+
+	sum := eval.Number(0)
+	for j := range weights {
+		weights[j] /= reference
+		sum += weights[j]
+	}
+
+	if g > tm.CountAlternatives {
+		g = tm.CountAlternatives
+	}
+	off := tm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = tm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				for k := 0; k < tm.CountAlternatives; k++ {
+					tm.Dominance[i][k] = 0
+					if i == k {
+						continue
+					}
+
+					for j, c := range tm.Criteria {
+						diff := eval.Crisp(tm.Data[i].Grade[j], tm.Defuzzification) - eval.Crisp(tm.Data[k].Grade[j], tm.Defuzzification)
+						if valueNorm == v.NormalizeWithSum && c.TypeOfCriteria == v.Cost {
+							diff = -diff
+						}
+						if diff == 0 || weights[j] == 0 {
+							continue
+						}
+
+						d, inerr := eval.Distance(tm.Data[i].Grade[j], tm.Data[k].Grade[j], vn)
+						if inerr != nil {
+							err = inerr
+							return
+						}
+
+						if diff > 0 {
+							tm.Dominance[i][k] += eval.Number(math.Sqrt(float64(weights[j] * d / sum)))
+						} else {
+							tm.Dominance[i][k] -= eval.Number(math.Sqrt(float64(sum*d/weights[j])) / theta)
+						}
+					}
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if err == nil {
+		tm.DominanceFind = true
+	}
+	return err
+}
+
+func (tm *TodimMatrix) CalcOverall() {
+	totals := make([]eval.Number, tm.CountAlternatives)
+	minimum, maximum := eval.NumbersMax, eval.NumbersMin
+	for i := range tm.Dominance {
+		for k := range tm.Dominance[i] {
+			totals[i] += tm.Dominance[i][k]
+		}
+		minimum = min(minimum, totals[i])
+		maximum = max(maximum, totals[i])
+	}
+
+	for i := range totals {
+		if maximum == minimum {
+			tm.Overall[i] = eval.Rating{Evaluated: eval.Number(1)}
+		} else {
+			tm.Overall[i] = eval.Rating{Evaluated: (totals[i] - minimum) / (maximum - minimum)}
+		}
+	}
+	tm.OverallFind = true
+}
+
+func (tm *TodimMatrix) RankedList() matrix.RankedList {
+	set := make([]eval.Rating, len(tm.Overall))
+	ind := make([]int, len(tm.Overall))
+	for i := range set {
+		ind[i] = i
+		set[i] = tm.Overall[i].CopyEval()
+	}
+
+	sort.Slice(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], tm.Defuzzification) > eval.Crisp(set[ind[j]], tm.Defuzzification)
+	})
+	sort.Slice(set, func(i, j int) bool {
+		return eval.Crisp(set[i], tm.Defuzzification) > eval.Crisp(set[j], tm.Defuzzification)
+	})
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
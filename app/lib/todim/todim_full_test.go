@@ -0,0 +1,80 @@
+package todim
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func TodimCalculating(todimMatrix *TodimMatrix, valueNorm, weightNorm v.Variants, theta float64) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(1, *todimMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	if err := todimMatrix.Normalization(valueNorm, weightNorm, 5); err != nil {
+		return nil, err
+	}
+
+	if err := todimMatrix.CalcDominance(valueNorm, theta, v.SqrtDistance, 5); err != nil {
+		return nil, err
+	}
+
+	todimMatrix.CalcOverall()
+
+	return todimMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *TodimMatrix
+		valueNorm  v.Variants
+		weightNorm v.Variants
+		theta      float64
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToTodimMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			theta:      DefaultTheta,
+			resultRow:  []eval.Number{0.880, 1.000, 0.267, 0.000},
+		},
+		{
+			initMat:    ConvertToTodimMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			theta:      2.5,
+			resultRow:  []eval.Number{0.921, 1.000, 0.230, 0.000},
+		},
+		{
+			initMat:    ConvertToTodimMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			theta:      DefaultTheta,
+			resultRow:  []eval.Number{0.000, 0.384, 1.000, 0.758},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := TodimCalculating(tt.initMat, tt.valueNorm, tt.weightNorm, tt.theta); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList())
+				for i, el := range res {
+					if math.Abs(float64(el.ConvertToNumber()-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", el.ConvertToNumber(), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package todim
+
+import (
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type TodimMatrix struct {
+	*matrix.Matrix
+	Dominance     [][]eval.Number `json:"dominance"`
+	DominanceFind bool            `json:"is_dominance_find"`
+	Overall       []eval.Rating   `json:"overall"`
+	OverallFind   bool            `json:"is_overall_find"`
+}
+
+func NewTodimMatrix(x, y int) *TodimMatrix {
+	return &TodimMatrix{
+		Matrix:    matrix.NewMatrix(x, y),
+		Dominance: newDominance(x),
+		Overall:   make([]eval.Rating, x),
+	}
+}
+
+func ConvertToTodimMatrix(m *matrix.Matrix) *TodimMatrix {
+	return &TodimMatrix{
+		Matrix:    matrix.CopyMatrix(m),
+		Dominance: newDominance(m.CountAlternatives),
+		Overall:   make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func newDominance(x int) [][]eval.Number {
+	dominance := make([][]eval.Number, x)
+	for i := range dominance {
+		dominance[i] = make([]eval.Number, x)
+	}
+	return dominance
+}
+
+func (tm *TodimMatrix) GetCoefs() []eval.Rating {
+	return tm.Overall
+}
+
+func (tm *TodimMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range tm.Data {
+		s += tm.Data[i].String() + "\n"
+	}
+
+	if tm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < tm.CountCriteria; i++ {
+			s += tm.Criteria[i].String() + " "
+		}
+	}
+
+	if tm.DominanceFind {
+		s += "\nDominance degrees:\n"
+		for i := range tm.Dominance {
+			for k := range tm.Dominance[i] {
+				s += tm.Dominance[i][k].String() + " "
+			}
+			s += "\n"
+		}
+	}
+
+	if tm.OverallFind {
+		s += "\nOverall values:\n"
+		for i := 0; i < tm.CountAlternatives; i++ {
+			s += tm.Overall[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateDominance(matrices []TodimMatrix, weights []eval.Evaluated) (*TodimMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewTodimMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i := 0; i < x; i++ {
+			for j := 0; j < x; j++ {
+				result.Dominance[i][j] += matrices[k].Dominance[i][j] * weights[k].ConvertToNumber()
+			}
+		}
+	}
+	result.DominanceFind = true
+	return result, nil
+}
@@ -16,8 +16,18 @@ func positiveIdeal(alts []matrix.Alternative, Criteria []matrix.Criterion, t str
 			return positiveIdealRateInterval0(alts, Criteria)
 		} else if t == (&eval.T1FS{}).GetType() {
 			return positiveIdealRateT1FS0(alts, Criteria, f)
+		} else if t == (&eval.IT2FS{}).GetType() {
+			return positiveIdealRateIT2FS0(alts, Criteria)
 		} else if t == (&eval.AIFS{}).GetType() {
 			return positiveIdealRateAIFS0(alts, Criteria)
+		} else if t == (&eval.PFS{}).GetType() {
+			return positiveIdealRatePFS0(alts, Criteria)
+		} else if t == (&eval.NS{}).GetType() {
+			return positiveIdealRateNS0(alts, Criteria)
+		} else if t == (&eval.HFS{}).GetType() {
+			return positiveIdealRateHFS0(alts, Criteria)
+		} else if t == (eval.Grey{}).GetType() {
+			return positiveIdealRateGrey0(alts, Criteria)
 		} else {
 			return matrix.Alternative{}, v.IncompatibleTypes
 		}
@@ -60,8 +70,18 @@ func positiveIdeal(alts []matrix.Alternative, Criteria []matrix.Criterion, t str
 						positive.Grade[i], tmpErr = positiveIdealRateInterval(alts, c, i)
 					} else if t == (&eval.T1FS{}).GetType() {
 						positive.Grade[i], tmpErr = positiveIdealRateT1FS(alts, c, i, f)
+					} else if t == (&eval.IT2FS{}).GetType() {
+						positive.Grade[i], tmpErr = positiveIdealRateIT2FS(alts, c, i)
 					} else if t == (&eval.AIFS{}).GetType() {
 						positive.Grade[i], tmpErr = positiveIdealRateAIFS(alts, c, i)
+					} else if t == (&eval.PFS{}).GetType() {
+						positive.Grade[i], tmpErr = positiveIdealRatePFS(alts, c, i)
+					} else if t == (&eval.NS{}).GetType() {
+						positive.Grade[i], tmpErr = positiveIdealRateNS(alts, c, i)
+					} else if t == (&eval.HFS{}).GetType() {
+						positive.Grade[i], tmpErr = positiveIdealRateHFS(alts, c, i)
+					} else if t == (eval.Grey{}).GetType() {
+						positive.Grade[i], tmpErr = positiveIdealRateGrey(alts, c, i)
 					} else {
 						cancel()
 						err = v.IncompatibleTypes
@@ -110,6 +130,120 @@ func positiveIdealRateAIFS(alts []matrix.Alternative, c matrix.Criterion, i int)
 	return positive, nil
 }
 
+// positiveIdealRateIT2FS takes the best of both upper and lower membership
+// functions, so the ideal keeps the footprint of uncertainty.
+func positiveIdealRateIT2FS(alts []matrix.Alternative, c matrix.Criterion, i int) (eval.Rating, error) {
+	positive := eval.Rating{}
+	for j := range alts {
+		if alts[j].Grade[i].GetType() != (&eval.IT2FS{}).GetType() {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		if positive.IsNil() {
+			positive = alts[j].Grade[i].CopyEval()
+			continue
+		}
+
+		if c.TypeOfCriteria == v.Benefit {
+			positive = eval.Max(positive, alts[j].Grade[i])
+		} else {
+			positive = eval.Min(positive, alts[j].Grade[i])
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRatePFS(alts []matrix.Alternative, c matrix.Criterion, i int) (eval.Rating, error) {
+	positive := eval.Rating{}
+	for j := range alts {
+		if alts[j].Grade[i].GetType() != (&eval.PFS{}).GetType() {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		altsGrade := alts[j].Grade[i].ConvertToPFS(v.Default)
+
+		if positive.IsNil() {
+			positive.Evaluated = eval.NewPFS(altsGrade.Mu, altsGrade.Nu, altsGrade.Vert...)
+			continue
+		}
+
+		if c.TypeOfCriteria == v.Benefit {
+			positive = eval.Max(positive, alts[j].Grade[i])
+		} else {
+			positive = eval.Min(positive, alts[j].Grade[i])
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRateNS(alts []matrix.Alternative, c matrix.Criterion, i int) (eval.Rating, error) {
+	positive := eval.Rating{}
+	for j := range alts {
+		if alts[j].Grade[i].GetType() != (&eval.NS{}).GetType() {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		if positive.IsNil() {
+			positive = alts[j].Grade[i].CopyEval()
+			continue
+		}
+
+		if c.TypeOfCriteria == v.Benefit {
+			positive = eval.Max(positive, alts[j].Grade[i])
+		} else {
+			positive = eval.Min(positive, alts[j].Grade[i])
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRateHFS(alts []matrix.Alternative, c matrix.Criterion, i int) (eval.Rating, error) {
+	positive := eval.Rating{}
+	for j := range alts {
+		if alts[j].Grade[i].GetType() != (&eval.HFS{}).GetType() {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		if positive.IsNil() {
+			positive = alts[j].Grade[i].CopyEval()
+			continue
+		}
+
+		if c.TypeOfCriteria == v.Benefit {
+			positive = eval.Max(positive, alts[j].Grade[i])
+		} else {
+			positive = eval.Min(positive, alts[j].Grade[i])
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRateGrey(alts []matrix.Alternative, c matrix.Criterion, i int) (eval.Rating, error) {
+	positive := eval.Rating{}
+	for j := range alts {
+		if alts[j].Grade[i].GetType() != (eval.Grey{}).GetType() {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		if positive.IsNil() {
+			positive = alts[j].Grade[i].CopyEval()
+			continue
+		}
+
+		if c.TypeOfCriteria == v.Benefit {
+			positive = eval.Max(positive, alts[j].Grade[i])
+		} else {
+			positive = eval.Min(positive, alts[j].Grade[i])
+		}
+	}
+
+	return positive, nil
+}
+
 func positiveIdealRateT1FS(alts []matrix.Alternative, c matrix.Criterion, i int, Form v.Variants) (eval.Rating, error) {
 	positive := eval.Rating{}
 
@@ -123,6 +257,8 @@ func positiveIdealRateT1FS(alts []matrix.Alternative, c matrix.Criterion, i int,
 				positive.Evaluated = eval.NewT1FS(eval.NumbersMin, eval.NumbersMin, eval.NumbersMin)
 			} else if Form == v.Trapezoid {
 				positive.Evaluated = eval.NewT1FS(eval.NumbersMin, eval.NumbersMin, eval.NumbersMin, eval.NumbersMin)
+			} else if alts[j].Grade[i].GetType() == (&eval.T1FS{}).GetType() {
+				positive = alts[j].Grade[i].CopyEval()
 			} else {
 				return eval.Rating{}, v.IncompatibleTypes
 			}
@@ -131,6 +267,8 @@ func positiveIdealRateT1FS(alts []matrix.Alternative, c matrix.Criterion, i int,
 				positive.Evaluated = eval.NewT1FS(eval.NumbersMax, eval.NumbersMax, eval.NumbersMax)
 			} else if Form == v.Trapezoid {
 				positive.Evaluated = eval.NewT1FS(eval.NumbersMax, eval.NumbersMax, eval.NumbersMax, eval.NumbersMax)
+			} else if alts[j].Grade[i].GetType() == (&eval.T1FS{}).GetType() {
+				positive = alts[j].Grade[i].CopyEval()
 			} else {
 				return eval.Rating{}, v.IncompatibleTypes
 			}
@@ -34,6 +34,108 @@ func positiveIdealRateAIFS0(alts []matrix.Alternative, Criteria []matrix.Criteri
 	return positive, nil
 }
 
+func positiveIdealRateIT2FS0(alts []matrix.Alternative, Criteria []matrix.Criterion) (matrix.Alternative, error) {
+	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
+
+	for i, c := range Criteria {
+		for j := range alts {
+			if alts[j].Grade[i].GetType() != (&eval.IT2FS{}).GetType() {
+				return matrix.Alternative{}, v.IncompatibleTypes
+			}
+
+			if positive.Grade[i].IsNil() {
+				positive.Grade[i] = alts[j].Grade[i].CopyEval()
+				continue
+			}
+
+			if c.TypeOfCriteria == v.Benefit {
+				positive.Grade[i] = eval.Max(positive.Grade[i], alts[j].Grade[i])
+			} else {
+				positive.Grade[i] = eval.Min(positive.Grade[i], alts[j].Grade[i])
+			}
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRatePFS0(alts []matrix.Alternative, Criteria []matrix.Criterion) (matrix.Alternative, error) {
+	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
+
+	for i, c := range Criteria {
+		for j := range alts {
+			if alts[j].Grade[i].GetType() != (&eval.PFS{}).GetType() {
+				return matrix.Alternative{}, v.IncompatibleTypes
+			}
+
+			altsGrade := alts[j].Grade[i].ConvertToPFS(v.Default)
+
+			if positive.Grade[i].IsNil() {
+				positive.Grade[i].Evaluated = eval.NewPFS(altsGrade.Mu, altsGrade.Nu, altsGrade.Vert...)
+				continue
+			}
+
+			if c.TypeOfCriteria == v.Benefit {
+				positive.Grade[i] = eval.Max(positive.Grade[i], alts[j].Grade[i])
+			} else {
+				positive.Grade[i] = eval.Min(positive.Grade[i], alts[j].Grade[i])
+			}
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRateNS0(alts []matrix.Alternative, Criteria []matrix.Criterion) (matrix.Alternative, error) {
+	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
+
+	for i, c := range Criteria {
+		for j := range alts {
+			if alts[j].Grade[i].GetType() != (&eval.NS{}).GetType() {
+				return matrix.Alternative{}, v.IncompatibleTypes
+			}
+
+			if positive.Grade[i].IsNil() {
+				positive.Grade[i] = alts[j].Grade[i].CopyEval()
+				continue
+			}
+
+			if c.TypeOfCriteria == v.Benefit {
+				positive.Grade[i] = eval.Max(positive.Grade[i], alts[j].Grade[i])
+			} else {
+				positive.Grade[i] = eval.Min(positive.Grade[i], alts[j].Grade[i])
+			}
+		}
+	}
+
+	return positive, nil
+}
+
+func positiveIdealRateHFS0(alts []matrix.Alternative, Criteria []matrix.Criterion) (matrix.Alternative, error) {
+	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
+
+	for i, c := range Criteria {
+		for j := range alts {
+			if alts[j].Grade[i].GetType() != (&eval.HFS{}).GetType() {
+				return matrix.Alternative{}, v.IncompatibleTypes
+			}
+
+			if positive.Grade[i].IsNil() {
+				positive.Grade[i] = alts[j].Grade[i].CopyEval()
+				continue
+			}
+
+			if c.TypeOfCriteria == v.Benefit {
+				positive.Grade[i] = eval.Max(positive.Grade[i], alts[j].Grade[i])
+			} else {
+				positive.Grade[i] = eval.Min(positive.Grade[i], alts[j].Grade[i])
+			}
+		}
+	}
+
+	return positive, nil
+}
+
 func positiveIdealRateT1FS0(alts []matrix.Alternative, Criteria []matrix.Criterion, Form v.Variants) (matrix.Alternative, error) {
 	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
 
@@ -46,14 +148,18 @@ func positiveIdealRateT1FS0(alts []matrix.Alternative, Criteria []matrix.Criteri
 			if positive.Grade[i].IsNil() && c.TypeOfCriteria == v.Benefit {
 				if Form == v.Triangle {
 					positive.Grade[i].Evaluated = eval.NewT1FS(eval.NumbersMin, eval.NumbersMin, eval.NumbersMin)
-				} else {
+				} else if Form == v.Trapezoid || alts[j].Grade[i].GetType() != (&eval.T1FS{}).GetType() {
 					positive.Grade[i].Evaluated = eval.NewT1FS(eval.NumbersMin, eval.NumbersMin, eval.NumbersMin, eval.NumbersMin)
+				} else {
+					positive.Grade[i] = alts[j].Grade[i].CopyEval()
 				}
 			} else if positive.Grade[i].IsNil() && c.TypeOfCriteria == v.Cost {
 				if Form == v.Triangle {
 					positive.Grade[i].Evaluated = eval.NewT1FS(eval.NumbersMax, eval.NumbersMax, eval.NumbersMax)
-				} else {
+				} else if Form == v.Trapezoid || alts[j].Grade[i].GetType() != (&eval.T1FS{}).GetType() {
 					positive.Grade[i].Evaluated = eval.NewT1FS(eval.NumbersMax, eval.NumbersMax, eval.NumbersMax, eval.NumbersMax)
+				} else {
+					positive.Grade[i] = alts[j].Grade[i].CopyEval()
 				}
 			}
 
@@ -154,3 +260,28 @@ func positiveIdealRateNumber0(alts []matrix.Alternative, Criteria []matrix.Crite
 
 	return positive, nil
 }
+
+func positiveIdealRateGrey0(alts []matrix.Alternative, Criteria []matrix.Criterion) (matrix.Alternative, error) {
+	positive := matrix.Alternative{Grade: make([]eval.Rating, len(alts[0].Grade)), CountOfCriteria: len(Criteria)}
+
+	for i, c := range Criteria {
+		for j := range alts {
+			if alts[j].Grade[i].GetType() != (eval.Grey{}).GetType() {
+				return matrix.Alternative{}, v.IncompatibleTypes
+			}
+
+			if positive.Grade[i].IsNil() {
+				positive.Grade[i] = alts[j].Grade[i].CopyEval()
+				continue
+			}
+
+			if c.TypeOfCriteria == v.Benefit {
+				positive.Grade[i] = eval.Max(positive.Grade[i], alts[j].Grade[i])
+			} else {
+				positive.Grade[i] = eval.Min(positive.Grade[i], alts[j].Grade[i])
+			}
+		}
+	}
+
+	return positive, nil
+}
@@ -34,8 +34,25 @@ func (tm *TopsisMatrix) FindIdeals(variants v.Variants, g int) error {
 				err = inerr
 			}
 		}()
-	} else if tm.Data[0].Grade[0].GetType() == (&eval.T1FS{}).GetType() ||
-		tm.Data[0].Grade[0].GetType() == (&eval.IT2FS{}).GetType() {
+	} else if tm.Data[0].Grade[0].GetType() == (&eval.IT2FS{}).GetType() {
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.PositiveIdeal, inerr = positiveIdeal(tm.Data, tm.Criteria, (&eval.IT2FS{}).GetType(), tm.FormFs, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.NegativeIdeal, inerr = negativeIdeal(tm.Data, tm.Criteria, (&eval.IT2FS{}).GetType(), tm.FormFs, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+	} else if tm.Data[0].Grade[0].GetType() == (&eval.T1FS{}).GetType() {
 		go func() {
 			defer wg.Done()
 			var inerr error
@@ -71,6 +88,78 @@ func (tm *TopsisMatrix) FindIdeals(variants v.Variants, g int) error {
 				err = inerr
 			}
 		}()
+	} else if tm.Data[0].Grade[0].GetType() == (&eval.HFS{}).GetType() {
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.PositiveIdeal, inerr = positiveIdeal(tm.Data, tm.Criteria, (&eval.HFS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.NegativeIdeal, inerr = negativeIdeal(tm.Data, tm.Criteria, (&eval.HFS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+	} else if tm.Data[0].Grade[0].GetType() == (&eval.NS{}).GetType() {
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.PositiveIdeal, inerr = positiveIdeal(tm.Data, tm.Criteria, (&eval.NS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.NegativeIdeal, inerr = negativeIdeal(tm.Data, tm.Criteria, (&eval.NS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+	} else if tm.Data[0].Grade[0].GetType() == (eval.Grey{}).GetType() {
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.PositiveIdeal, inerr = positiveIdeal(tm.Data, tm.Criteria, (eval.Grey{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.NegativeIdeal, inerr = negativeIdeal(tm.Data, tm.Criteria, (eval.Grey{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+	} else if tm.Data[0].Grade[0].GetType() == (&eval.PFS{}).GetType() {
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.PositiveIdeal, inerr = positiveIdeal(tm.Data, tm.Criteria, (&eval.PFS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			var inerr error
+			tm.NegativeIdeal, inerr = negativeIdeal(tm.Data, tm.Criteria, (&eval.PFS{}).GetType(), v.None, g)
+			if inerr != nil {
+				err = inerr
+			}
+		}()
 	} else {
 		go func() {
 			defer wg.Done()
@@ -120,14 +209,16 @@ func (tm *TopsisMatrix) FindDistanceToIdeals(vt, vi, vn v.Variants, g int) error
 
 			for i := start; i < end; i++ {
 				if (tm.HighType != (&eval.T1FS{}).GetType() && tm.HighType != (&eval.IT2FS{}).GetType() &&
-					tm.HighType != (&eval.AIFS{}).GetType()) || vt == v.AlphaSlices {
+					tm.HighType != (&eval.AIFS{}).GetType() && tm.HighType != (&eval.PFS{}).GetType() &&
+					tm.HighType != (&eval.NS{}).GetType() && tm.HighType != (&eval.HFS{}).GetType() &&
+					tm.HighType != (eval.Grey{}).GetType()) || vt == v.AlphaSlices {
 					if vi == v.Default {
-						if tm.DistancesToPositive[i].Evaluated, inerr = tm.Data[i].NumberMetric(tm.PositiveIdeal, vn); inerr != nil {
+						if tm.DistancesToPositive[i].Evaluated, inerr = tm.Data[i].NumberMetric(tm.PositiveIdeal, vn, tm.Defuzzification); inerr != nil {
 							err = inerr
 							return
 						}
 
-						if tm.DistancesToNegative[i].Evaluated, inerr = tm.Data[i].NumberMetric(tm.NegativeIdeal, vn); inerr != nil {
+						if tm.DistancesToNegative[i].Evaluated, inerr = tm.Data[i].NumberMetric(tm.NegativeIdeal, vn, tm.Defuzzification); inerr != nil {
 							err = inerr
 							return
 						}
@@ -220,12 +311,23 @@ func (tm *TopsisMatrix) RankedList(ranking v.Variants) matrix.RankedList {
 			r := set[j].ConvertToInterval()
 			return l.SenguptaGeq(r)
 		})
+	} else if ranking == v.GreyPossibility {
+		sort.Slice(ind, func(i, j int) bool {
+			l := set[ind[i]].ConvertToGrey()
+			r := set[ind[j]].ConvertToGrey()
+			return l.PossibilityGeq(r)
+		})
+		sort.Slice(set, func(i, j int) bool {
+			l := set[i].ConvertToGrey()
+			r := set[j].ConvertToGrey()
+			return l.PossibilityGeq(r)
+		})
 	} else {
 		sort.Slice(ind, func(i, j int) bool {
-			return set[ind[i]].ConvertToNumber() > set[ind[j]].ConvertToNumber()
+			return eval.Crisp(set[ind[i]], tm.Defuzzification) > eval.Crisp(set[ind[j]], tm.Defuzzification)
 		})
 		sort.Slice(set, func(i, j int) bool {
-			return set[i].ConvertToNumber() > set[j].ConvertToNumber()
+			return eval.Crisp(set[i], tm.Defuzzification) > eval.Crisp(set[j], tm.Defuzzification)
 		})
 	}
 	return matrix.RankedList{Coeffs: set, Order: ind}
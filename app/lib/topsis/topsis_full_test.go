@@ -37,6 +37,59 @@ func TopsisCalculating(topsisMatrix *TopsisMatrix, valueNorm, weightNorm, idelaA
 	return topsisMatrix.GetCoefs(), nil
 }
 
+// shapedMatrix rates alternatives with Gaussian, bell-shaped and
+// piecewise-linear fuzzy numbers, the last one of non-unit height.
+func shapedMatrix() *matrix.Matrix {
+	m := matrix.NewMatrix(3, 3)
+	ratings := [][]eval.Evaluated{
+		{eval.NewGaussianT1FS(5, 1), eval.NewBellT1FS(2, 2, 6),
+			eval.NewPiecewiseT1FS([]eval.Number{1, 3, 4, 7}, []eval.Number{0, 1, 0.8, 0})},
+		{eval.NewGaussianT1FS(7, 1.5), eval.NewBellT1FS(1, 3, 4),
+			eval.NewPiecewiseT1FS([]eval.Number{2, 4, 6, 8}, []eval.Number{0, 0.6, 0.9, 0})},
+		{eval.NewGaussianT1FS(3, 0.5), eval.NewBellT1FS(3, 1, 8),
+			eval.NewPiecewiseT1FS([]eval.Number{0, 2, 5}, []eval.Number{0, 1, 0})},
+	}
+
+	for i := range ratings {
+		for j := range ratings[i] {
+			_ = m.SetValue(ratings[i][j], i, j)
+		}
+	}
+
+	_ = m.SetCriterion(eval.Number(0.4), v.Benefit, 0)
+	_ = m.SetCriterion(eval.Number(0.35), v.Cost, 1)
+	_ = m.SetCriterion(eval.Number(0.25), v.Benefit, 2)
+	return m
+}
+
+// footprintMatrix rates alternatives with interval type-2 fuzzy numbers,
+// whose ideals and distances keep upper and lower membership functions.
+func footprintMatrix() *matrix.Matrix {
+	m := matrix.NewMatrix(3, 3)
+	ratings := [][]*eval.IT2FS{
+		{eval.NewIT2FS([]eval.Interval{{Start: 1, End: 2}, {Start: 6, End: 7}}, []eval.Number{4}),
+			eval.NewIT2FS([]eval.Interval{{Start: 2, End: 3}, {Start: 7, End: 9}}, []eval.Number{5}),
+			eval.NewIT2FS([]eval.Interval{{Start: 3, End: 4}, {Start: 8, End: 9}}, []eval.Number{6})},
+		{eval.NewIT2FS([]eval.Interval{{Start: 3, End: 4}, {Start: 8, End: 9}}, []eval.Number{6}),
+			eval.NewIT2FS([]eval.Interval{{Start: 1, End: 2}, {Start: 4, End: 5}}, []eval.Number{3}),
+			eval.NewIT2FS([]eval.Interval{{Start: 2, End: 3}, {Start: 6, End: 8}}, []eval.Number{5})},
+		{eval.NewIT2FS([]eval.Interval{{Start: 2, End: 3}, {Start: 5, End: 6}}, []eval.Number{4}),
+			eval.NewIT2FS([]eval.Interval{{Start: 4, End: 5}, {Start: 8, End: 9}}, []eval.Number{7}),
+			eval.NewIT2FS([]eval.Interval{{Start: 1, End: 2}, {Start: 5, End: 6}}, []eval.Number{3})},
+	}
+
+	for i := range ratings {
+		for j := range ratings[i] {
+			_ = m.SetValue(ratings[i][j], i, j)
+		}
+	}
+
+	_ = m.SetCriterion(eval.Number(0.5), v.Benefit, 0)
+	_ = m.SetCriterion(eval.Number(0.3), v.Cost, 1)
+	_ = m.SetCriterion(eval.Number(0.2), v.Benefit, 2)
+	return m
+}
+
 func TestTableDriven(t *testing.T) {
 	var tests = []struct {
 		initMat                                                   *TopsisMatrix
@@ -83,6 +136,86 @@ func TestTableDriven(t *testing.T) {
 			numDist:    v.CbrtDistance,
 			resultRow:  []eval.Number{0.604, 0.472, 0.445, 0.314},
 		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.PFS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.850, 0.731, 0.124, 0.219},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.NS{}), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.548, 0.432, 0.395, 0.710},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.NS{}), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.HammingDistance,
+			resultRow:  []eval.Number{0.568, 0.400, 0.326, 0.821},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.HFS{}), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.461, 0.461, 0.574, 0.538},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Grey{}), reflect.TypeOf(eval.Number(0)), 150)),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.GreyPossibility,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.356, 0.395, 0.630},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.ZNumber{}), reflect.TypeOf(eval.Number(0)), 100)),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.611, 0.432, 0.274, 0.498},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(shapedMatrix()),
+			valueNorm:  v.NormalizeWithSum,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.493, 0.860, 0.141},
+		},
+		{
+			initMat:    ConvertToTopsisMatrix(footprintMatrix()),
+			valueNorm:  v.NormalizeValueWithMax,
+			weightNorm: v.NormalizeWithSum,
+			idelaAlg:   v.Default,
+			fsDist:     v.Default,
+			intDist:    v.Default,
+			numDist:    v.SqrtDistance,
+			resultRow:  []eval.Number{0.457, 0.556, 0.452},
+		},
 		{
 			initMat:    ConvertToTopsisMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.IT2FS{}), reflect.TypeOf(eval.Interval{}), 108)),
 			valueNorm:  v.NormalizeValueWithMax,
@@ -94,7 +227,7 @@ func TestTableDriven(t *testing.T) {
 			resultRow:  []eval.Number{0.301, 0.334, 0.292},
 		},
 	}
-	fmt.Println(tests[4].initMat)
+	fmt.Println(tests[5].initMat)
 	for i, tt := range tests {
 		testname := fmt.Sprintf("%d test", i)
 		t.Run(testname, func(t *testing.T) {
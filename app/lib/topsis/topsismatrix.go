@@ -82,6 +82,7 @@ func (tm *TopsisMatrix) String() string {
 func AggregateDistances(matrices []TopsisMatrix, weights []eval.Evaluated) (*TopsisMatrix, error) {
 	x := matrices[0].CountAlternatives
 	result := NewTopsisMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
 
 	for k := range matrices {
 		if x != matrices[k].CountAlternatives {
@@ -9,6 +9,16 @@ type Variants int
 const (
 	TOPSIS      = "topsis"
 	SMART       = "smart"
+	VIKOR       = "vikor"
+	PROMETHEE   = "promethee"
+	ELECTRE     = "electre"
+	MULTIMOORA  = "multimoora"
+	EDAS        = "edas"
+	CODAS       = "codas"
+	MABAC       = "mabac"
+	ARAS        = "aras"
+	TODIM       = "todim"
+	GRA         = "gra"
 	Individuals = "individual"
 	Group       = "group"
 )
@@ -19,9 +29,74 @@ const (
 )
 
 const (
-	None      = 0
-	Triangle  = 1
-	Trapezoid = 2
+	None            = 0
+	Triangle        = 1
+	Trapezoid       = 2
+	Gaussian        = 3
+	Bell            = 4
+	PiecewiseLinear = 5
+)
+
+const (
+	UsualCriterion    = 0
+	UShapeCriterion   = 1
+	VShapeCriterion   = 2
+	LevelCriterion    = 3
+	LinearCriterion   = 4
+	GaussianCriterion = 5
+)
+
+const (
+	Incomparability   = 0
+	Preference        = 1
+	Indifference      = 2
+	InversePreference = 3
+)
+
+const (
+	EigenvectorMethod   = 0
+	GeometricMeanMethod = 1
+	BuckleyMethod       = 2
+	ChangMethod         = 3
+	LinearBWM           = 4
+	NonlinearBWM        = 5
+)
+
+const (
+	SubjectiveWeights = 0
+	EntropyWeights    = 1
+	CriticWeights     = 2
+	MerecWeights      = 3
+)
+
+const (
+	ObjectiveOnly     = 0
+	MultiplicativeMix = 1
+	LinearMix         = 2
+)
+
+const (
+	AdditiveScore = 0
+	ProductScore  = 1
+	WaspasScore   = 2
+)
+
+const (
+	AlphaCutAverage       = 0
+	Centroid              = 1
+	Bisector              = 2
+	MeanOfMaxima          = 3
+	GradedMeanIntegration = 4
+)
+
+const (
+	KarnikMendel         = 0
+	EnhancedKarnikMendel = 1
+)
+
+const (
+	DominanceFusion = 0
+	BordaFusion     = 1
 )
 
 const (
@@ -35,6 +110,8 @@ const (
 	AggregateMatrix            = 0b00111
 	AggregateFinals            = 0b01000
 	Default                    = 0b01010
+	HammingDistance            = 0b01011
+	GreyPossibility            = 0b01100
 )
 
 var (
@@ -44,4 +121,8 @@ var (
 	EmptyValues            = errors.New("no values specified")
 	IncompatibleTypes      = errors.New("incompatible types for operation")
 	NoUsageMethod          = errors.New("this method shouldn't have usage")
+	InconsistentMatrix     = errors.New("pairwise comparisons are inconsistent")
+	SingularMatrix         = errors.New("matrix is singular")
+	InvalidMembership      = errors.New("invalid degrees of membership")
+	InvalidExpression      = errors.New("invalid linguistic expression")
 )
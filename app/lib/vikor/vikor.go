@@ -0,0 +1,290 @@
+package vikor
+
+import (
+	"sort"
+	"sync"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+const DefaultStrategyWeight = 0.5
+
+func bounds(e eval.Evaluated) (eval.Number, eval.Number) {
+	if e.GetType() == (eval.Interval{}).GetType() {
+		return e.ConvertToInterval().Start, e.ConvertToInterval().End
+	} else if e.GetType() == (eval.Grey{}).GetType() {
+		return e.ConvertToGrey().Lower, e.ConvertToGrey().Upper
+	} else if e.GetType() == (&eval.T1FS{}).GetType() {
+		vert := e.ConvertToT1FS(v.Default).Vert
+		return vert[0], vert[len(vert)-1]
+	}
+	return e.ConvertToNumber(), e.ConvertToNumber()
+}
+
+func subtract(a, b eval.Evaluated) (eval.Rating, error) {
+	if a.GetType() != b.GetType() {
+		return eval.Rating{}, v.IncompatibleTypes
+	}
+
+	if a.GetType() == eval.NumbersMin.GetType() {
+		return eval.Rating{Evaluated: a.ConvertToNumber() - b.ConvertToNumber()}, nil
+	} else if a.GetType() == (eval.Interval{}).GetType() {
+		return eval.Rating{Evaluated: eval.Interval{Start: a.ConvertToInterval().Start - b.ConvertToInterval().End,
+			End: a.ConvertToInterval().End - b.ConvertToInterval().Start}}, nil
+	} else if a.GetType() == (eval.Grey{}).GetType() {
+		return eval.Rating{Evaluated: eval.Grey{Lower: a.ConvertToGrey().Lower - b.ConvertToGrey().Upper,
+			Upper: a.ConvertToGrey().Upper - b.ConvertToGrey().Lower}}, nil
+	} else if a.GetType() == (&eval.T1FS{}).GetType() {
+		at, bt := a.ConvertToT1FS(v.Default), b.ConvertToT1FS(v.Default)
+		if len(at.Vert) != len(bt.Vert) {
+			return eval.Rating{}, v.IncompatibleTypes
+		}
+
+		vert := make([]eval.Number, len(at.Vert))
+		for k := range vert {
+			vert[k] = at.Vert[k] - bt.Vert[len(vert)-k-1]
+		}
+		return eval.Rating{Evaluated: eval.NewT1FS(vert...)}, nil
+	}
+	return eval.Rating{}, v.IncompatibleTypes
+}
+
+func ratio(x, best, worst eval.Evaluated, typeOfCriterion bool) (eval.Rating, error) {
+	var diff eval.Rating
+	var err error
+	var spread eval.Number
+
+	bestLower, bestUpper := bounds(best)
+	worstLower, worstUpper := bounds(worst)
+	if typeOfCriterion == v.Benefit {
+		diff, err = subtract(best, x)
+		spread = bestUpper - worstLower
+	} else {
+		diff, err = subtract(x, best)
+		spread = worstUpper - bestLower
+	}
+
+	if err != nil {
+		return eval.Rating{}, err
+	}
+
+	if spread == 0 {
+		return diff.Weighted(eval.Number(0)), nil
+	}
+	return diff.Weighted(1 / spread), nil
+}
+
+func (vm *VikorMatrix) FindIdeals(g int) error {
+	var wg sync.WaitGroup
+	var err error
+
+	t := vm.Data[0].Grade[0].GetType()
+	if t != eval.NumbersMin.GetType() && t != (eval.Interval{}).GetType() && t != (eval.Grey{}).GetType() &&
+		t != (&eval.T1FS{}).GetType() {
+		return v.IncompatibleTypes
+	}
+
+	vm.BestValues = matrix.Alternative{Grade: make([]eval.Rating, vm.CountCriteria), CountOfCriteria: vm.CountCriteria}
+	vm.WorstValues = matrix.Alternative{Grade: make([]eval.Rating, vm.CountCriteria), CountOfCriteria: vm.CountCriteria}
+
+	if g > vm.CountCriteria {
+		g = vm.CountCriteria
+	}
+	off := vm.CountCriteria / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = vm.CountCriteria
+			}
+
+			for j := start; j < end; j++ {
+				for i := range vm.Data {
+					if vm.Data[i].Grade[j].GetType() != t {
+						err = v.IncompatibleTypes
+						return
+					}
+
+					if i == 0 {
+						vm.BestValues.Grade[j] = vm.Data[i].Grade[j].CopyEval()
+						vm.WorstValues.Grade[j] = vm.Data[i].Grade[j].CopyEval()
+						continue
+					}
+
+					if vm.Criteria[j].TypeOfCriteria == v.Benefit {
+						vm.BestValues.Grade[j] = eval.Max(vm.BestValues.Grade[j], vm.Data[i].Grade[j])
+						vm.WorstValues.Grade[j] = eval.Min(vm.WorstValues.Grade[j], vm.Data[i].Grade[j])
+					} else {
+						vm.BestValues.Grade[j] = eval.Min(vm.BestValues.Grade[j], vm.Data[i].Grade[j])
+						vm.WorstValues.Grade[j] = eval.Max(vm.WorstValues.Grade[j], vm.Data[i].Grade[j])
+					}
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if err == nil {
+		vm.IdealsFind = true
+	}
+	return err
+}
+
+func (vm *VikorMatrix) CalcIndexes(g int) error {
+	var wg sync.WaitGroup
+	var err error
+	if g > vm.CountAlternatives {
+		g = vm.CountAlternatives
+	}
+	off := vm.CountAlternatives / g
+	wg.Add(g)
+	for b := 0; b < g; b++ {
+		go func(b int) {
+			defer wg.Done()
+
+			start := b * off
+			end := (b + 1) * off
+			if b == g-1 {
+				end = vm.CountAlternatives
+			}
+
+			for i := start; i < end; i++ {
+				for j, c := range vm.Criteria {
+					d, inerr := ratio(vm.Data[i].Grade[j], vm.BestValues.Grade[j], vm.WorstValues.Grade[j], c.TypeOfCriteria)
+					if inerr != nil {
+						err = inerr
+						return
+					}
+					d = d.Weighted(c.Weight)
+
+					if j == 0 {
+						vm.GroupUtility[i] = d
+						vm.IndividualRegret[i] = d.CopyEval()
+						continue
+					}
+					vm.GroupUtility[i] = vm.GroupUtility[i].Sum(d)
+					vm.IndividualRegret[i] = eval.Max(vm.IndividualRegret[i], d)
+				}
+			}
+		}(b)
+	}
+	wg.Wait()
+
+	if err == nil {
+		vm.IndexesFind = true
+	}
+	return err
+}
+
+func (vm *VikorMatrix) CalcCompromise(strategy float64) error {
+	bestS, worstS := vm.GroupUtility[0].CopyEval(), vm.GroupUtility[0].CopyEval()
+	bestR, worstR := vm.IndividualRegret[0].CopyEval(), vm.IndividualRegret[0].CopyEval()
+	for i := 1; i < vm.CountAlternatives; i++ {
+		bestS = eval.Min(bestS, vm.GroupUtility[i])
+		worstS = eval.Max(worstS, vm.GroupUtility[i])
+		bestR = eval.Min(bestR, vm.IndividualRegret[i])
+		worstR = eval.Max(worstR, vm.IndividualRegret[i])
+	}
+
+	for i := 0; i < vm.CountAlternatives; i++ {
+		s, err := ratio(vm.GroupUtility[i], bestS, worstS, v.Cost)
+		if err != nil {
+			return err
+		}
+
+		r, err := ratio(vm.IndividualRegret[i], bestR, worstR, v.Cost)
+		if err != nil {
+			return err
+		}
+
+		vm.Compromise[i] = s.Weighted(eval.Number(strategy)).Sum(r.Weighted(eval.Number(1 - strategy)))
+	}
+
+	vm.CompromiseFind = true
+	return nil
+}
+
+func rankAscending(set []eval.Rating, df v.Variants) []int {
+	ind := make([]int, len(set))
+	for i := range ind {
+		ind[i] = i
+	}
+
+	sort.SliceStable(ind, func(i, j int) bool {
+		return eval.Crisp(set[ind[i]], df) < eval.Crisp(set[ind[j]], df)
+	})
+	return ind
+}
+
+func (vm *VikorMatrix) CheckAcceptance() Acceptance {
+	order := rankAscending(vm.Compromise, vm.Defuzzification)
+	result := Acceptance{AcceptableAdvantage: true, AcceptableStability: true, CompromiseSet: []int{order[0]}}
+	if len(order) < 2 {
+		return result
+	}
+
+	dq := 1 / eval.Number(len(order)-1)
+	first := eval.Crisp(vm.Compromise[order[0]], vm.Defuzzification)
+	if eval.Crisp(vm.Compromise[order[1]], vm.Defuzzification)-first < dq {
+		result.AcceptableAdvantage = false
+	}
+
+	if rankAscending(vm.GroupUtility, vm.Defuzzification)[0] != order[0] && rankAscending(vm.IndividualRegret, vm.Defuzzification)[0] != order[0] {
+		result.AcceptableStability = false
+	}
+
+	if !result.AcceptableAdvantage {
+		for k := 1; k < len(order) && eval.Crisp(vm.Compromise[order[k]], vm.Defuzzification)-first < dq; k++ {
+			result.CompromiseSet = append(result.CompromiseSet, order[k])
+		}
+	} else if !result.AcceptableStability {
+		result.CompromiseSet = append(result.CompromiseSet, order[1])
+	}
+	return result
+}
+
+func (vm *VikorMatrix) RankedList(ranking v.Variants) matrix.RankedList {
+	set := make([]eval.Rating, len(vm.Compromise))
+	ind := make([]int, len(vm.Compromise))
+	for i := range set {
+		ind[i] = i
+		set[i] = vm.Compromise[i].CopyEval()
+	}
+
+	if ranking == v.Sengupta {
+		sort.Slice(ind, func(i, j int) bool {
+			l := set[ind[i]].ConvertToInterval()
+			r := set[ind[j]].ConvertToInterval()
+			return r.SenguptaGeq(l)
+		})
+		sort.Slice(set, func(i, j int) bool {
+			l := set[i].ConvertToInterval()
+			r := set[j].ConvertToInterval()
+			return r.SenguptaGeq(l)
+		})
+	} else if ranking == v.GreyPossibility {
+		sort.Slice(ind, func(i, j int) bool {
+			l := set[ind[i]].ConvertToGrey()
+			r := set[ind[j]].ConvertToGrey()
+			return r.PossibilityGeq(l)
+		})
+		sort.Slice(set, func(i, j int) bool {
+			l := set[i].ConvertToGrey()
+			r := set[j].ConvertToGrey()
+			return r.PossibilityGeq(l)
+		})
+	} else {
+		sort.Slice(ind, func(i, j int) bool {
+			return eval.Crisp(set[ind[i]], vm.Defuzzification) < eval.Crisp(set[ind[j]], vm.Defuzzification)
+		})
+		sort.Slice(set, func(i, j int) bool {
+			return eval.Crisp(set[i], vm.Defuzzification) < eval.Crisp(set[j], vm.Defuzzification)
+		})
+	}
+	return matrix.RankedList{Coeffs: set, Order: ind}
+}
@@ -0,0 +1,89 @@
+package vikor
+
+import (
+	"fmt"
+	"go.uber.org/goleak"
+	"math"
+	"reflect"
+	"testing"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+func VikorCalculating(vikorMatrix *VikorMatrix, weightNorm v.Variants, strategy float64) ([]eval.Rating, error) {
+	if err := matrix.TypingMatrices(5, *vikorMatrix.Matrix); err != nil {
+		return nil, err
+	}
+
+	if err := vikorMatrix.NormalizationWeights(weightNorm); err != nil {
+		return nil, err
+	}
+
+	if err := vikorMatrix.FindIdeals(5); err != nil {
+		return nil, err
+	}
+
+	if err := vikorMatrix.CalcIndexes(5); err != nil {
+		return nil, err
+	}
+
+	if err := vikorMatrix.CalcCompromise(strategy); err != nil {
+		return nil, err
+	}
+
+	return vikorMatrix.GetCoefs(), nil
+}
+
+func TestTableDriven(t *testing.T) {
+	var tests = []struct {
+		initMat    *VikorMatrix
+		weightNorm v.Variants
+		strategy   float64
+		resultRow  []eval.Number
+	}{
+		{
+			initMat:    ConvertToVikorMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Number(0)), reflect.TypeOf(eval.Number(0)), 100)),
+			weightNorm: v.NormalizeWithSum,
+			strategy:   DefaultStrategyWeight,
+			resultRow:  []eval.Number{0, 0.231, 0.550, 0.969},
+		},
+		{
+			initMat:    ConvertToVikorMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Interval{}), reflect.TypeOf(eval.Interval{}), 150)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			strategy:   DefaultStrategyWeight,
+			resultRow:  []eval.Number{0.073, 0.339, 0.277},
+		},
+		{
+			initMat:    ConvertToVikorMatrix(matrix.GenerateMatrix(reflect.TypeOf(&eval.T1FS{}), reflect.TypeOf(eval.Interval{}), 100)),
+			weightNorm: v.NormalizeWeightsByMidPoint,
+			strategy:   0.7,
+			resultRow:  []eval.Number{0.281, 0.196, 0.064, 0.113},
+		},
+		{
+			initMat:    ConvertToVikorMatrix(matrix.GenerateMatrix(reflect.TypeOf(eval.Grey{}), reflect.TypeOf(eval.Number(0)), 150)),
+			weightNorm: v.NormalizeWithSum,
+			strategy:   DefaultStrategyWeight,
+			resultRow:  []eval.Number{0.105, 0.271, 0.013},
+		},
+	}
+
+	for i, tt := range tests {
+		testname := fmt.Sprintf("%d test", i)
+		t.Run(testname, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+			if res, err := VikorCalculating(tt.initMat, tt.weightNorm, tt.strategy); err != nil {
+				t.Errorf(err.Error())
+			} else {
+				fmt.Println(tt.initMat.String())
+				fmt.Println(tt.initMat.RankedList(v.Default), tt.initMat.CheckAcceptance())
+				fmt.Println(tt.initMat.RankedList(v.GreyPossibility))
+				for i, el := range res {
+					if math.Abs(float64(eval.Defuzzify(el)-tt.resultRow[i])) > 0.01 {
+						t.Errorf("got %f, want %f\n", eval.Defuzzify(el), tt.resultRow[i])
+					}
+				}
+			}
+		})
+	}
+}
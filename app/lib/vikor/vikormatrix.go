@@ -0,0 +1,142 @@
+package vikor
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"webApp/lib/eval"
+	"webApp/lib/matrix"
+	v "webApp/lib/variables"
+)
+
+type VikorMatrix struct {
+	*matrix.Matrix
+	BestValues       matrix.Alternative `json:"best_values"`
+	WorstValues      matrix.Alternative `json:"worst_values"`
+	IdealsFind       bool               `json:"is_ideal_find"`
+	GroupUtility     []eval.Rating      `json:"group_utility"`
+	IndividualRegret []eval.Rating      `json:"individual_regret"`
+	IndexesFind      bool               `json:"is_indexes_find"`
+	Compromise       []eval.Rating      `json:"compromise"`
+	CompromiseFind   bool               `json:"is_compromise_find"`
+}
+
+type Acceptance struct {
+	AcceptableAdvantage bool  `json:"acceptable_advantage"`
+	AcceptableStability bool  `json:"acceptable_stability"`
+	CompromiseSet       []int `json:"compromise_set"`
+}
+
+func (a Acceptance) Value() (driver.Value, error) {
+	data, err := json.Marshal(a)
+	return string(data), err
+}
+
+func (a *Acceptance) Scan(src interface{}) error {
+	var tmp Acceptance
+	var err error
+	switch src.(type) {
+	case string:
+		err = json.Unmarshal([]byte(src.(string)), &tmp)
+	case []byte:
+		err = json.Unmarshal(src.([]byte), &tmp)
+	case nil:
+		return nil
+	default:
+		return errors.New("incompatible type for Acceptance")
+	}
+	if err != nil {
+		return err
+	}
+	*a = tmp
+	return nil
+}
+
+func NewVikorMatrix(x, y int) *VikorMatrix {
+	return &VikorMatrix{
+		Matrix:           matrix.NewMatrix(x, y),
+		GroupUtility:     make([]eval.Rating, x),
+		IndividualRegret: make([]eval.Rating, x),
+		Compromise:       make([]eval.Rating, x),
+	}
+}
+
+func ConvertToVikorMatrix(m *matrix.Matrix) *VikorMatrix {
+	return &VikorMatrix{
+		Matrix:           matrix.CopyMatrix(m),
+		GroupUtility:     make([]eval.Rating, m.CountAlternatives),
+		IndividualRegret: make([]eval.Rating, m.CountAlternatives),
+		Compromise:       make([]eval.Rating, m.CountAlternatives),
+	}
+}
+
+func (vm *VikorMatrix) GetCoefs() []eval.Rating {
+	return vm.Compromise
+}
+
+func (vm *VikorMatrix) String() string {
+	s := "Decision matrix:\n"
+	for i := range vm.Data {
+		s += vm.Data[i].String() + "\n"
+	}
+
+	if vm.CriteriaSet {
+		s += "\nWeights of Criteria:\n"
+		for i := 0; i < vm.CountCriteria; i++ {
+			s += vm.Criteria[i].String() + " "
+		}
+	}
+
+	if vm.IdealsFind {
+		s += "\nBest values:\n" + vm.BestValues.String() +
+			"\nWorst values:\n" + vm.WorstValues.String()
+	}
+
+	if vm.IndexesFind {
+		s += "\nGroup utility:\n"
+		for i := 0; i < vm.CountAlternatives; i++ {
+			s += vm.GroupUtility[i].String() + " "
+		}
+		s += "\nIndividual regret:\n"
+		for i := 0; i < vm.CountAlternatives; i++ {
+			s += vm.IndividualRegret[i].String() + " "
+		}
+	}
+
+	if vm.CompromiseFind {
+		s += "\nCompromise index:\n"
+		for i := 0; i < vm.CountAlternatives; i++ {
+			s += vm.Compromise[i].String() + " "
+		}
+		s += "\n"
+	}
+	return s
+}
+
+func AggregateIndexes(matrices []VikorMatrix, weights []eval.Evaluated) (*VikorMatrix, error) {
+	x := matrices[0].CountAlternatives
+	result := NewVikorMatrix(matrices[0].CountAlternatives, matrices[0].CountCriteria)
+	result.Defuzzification = matrices[0].Defuzzification
+
+	for k := range matrices {
+		if x != matrices[k].CountAlternatives {
+			return nil, v.InvalidSize
+		}
+		for i, s := range matrices[k].GroupUtility {
+			if k == 0 {
+				result.GroupUtility[i] = s.Weighted(weights[k])
+				continue
+			}
+			result.GroupUtility[i] = result.GroupUtility[i].Sum(s.Weighted(weights[k]))
+		}
+		for i, r := range matrices[k].IndividualRegret {
+			if k == 0 {
+				result.IndividualRegret[i] = r.Weighted(weights[k])
+				continue
+			}
+			result.IndividualRegret[i] = result.IndividualRegret[i].Sum(r.Weighted(weights[k]))
+		}
+	}
+	result.IndexesFind = true
+	return result, nil
+}
@@ -37,29 +37,32 @@ func (f *FinalDao) GetFinal(ctx context.Context, sid int64) (*entity.FinalModel,
 }
 
 func (f *FinalDao) SetFinal(ctx context.Context, final *entity.FinalModel) error {
-	query := fmt.Sprintf("INSERT INTO %s (fid, result, sens_analysis, threshold, last_change) values ($1, $2, $3, $4, $5)",
-		f.cfg.FinalTable)
+	query := fmt.Sprintf(`INSERT INTO %s (fid, result, acceptance, partial_order, sub_rankings, reliability,
+		terms, sens_analysis, threshold, last_change) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`, f.cfg.FinalTable)
 
 	conn := f.c.GetConnection()
 	if conn == nil {
 		return errors.New("cant connect to db")
 	}
 
-	if _, err := conn.ExecContext(ctx, query, final.FID, final.Result, final.SensAnalysis, final.Threshold, time.Now()); err != nil {
+	if _, err := conn.ExecContext(ctx, query, final.FID, final.Result, final.Acceptance, final.PartialOrder, final.SubRankings,
+		final.Reliability, final.Terms, final.SensAnalysis, final.Threshold, time.Now()); err != nil {
 		return errors.Join(err, f.c.CloseConnection())
 	}
 	return f.c.CloseConnection()
 }
 
 func (f *FinalDao) UpdateFinal(ctx context.Context, final *entity.FinalModel) error {
-	query := fmt.Sprintf("UPDATE %s SET result=$1, sens_analysis=$2, threshold=$3, last_change=$4 WHERE fid=$5", f.cfg.FinalTable)
+	query := fmt.Sprintf(`UPDATE %s SET result=$1, acceptance=$2, partial_order=$3, sub_rankings=$4, reliability=$5,
+		terms=$6, sens_analysis=$7, threshold=$8, last_change=$9 WHERE fid=$10`, f.cfg.FinalTable)
 
 	conn := f.c.GetConnection()
 	if conn == nil {
 		return errors.New("cant connect to db")
 	}
 
-	if result, err := conn.ExecContext(ctx, query, final.Result, final.SensAnalysis, final.Threshold, time.Now(), final.FID); err != nil {
+	if result, err := conn.ExecContext(ctx, query, final.Result, final.Acceptance, final.PartialOrder,
+		final.SubRankings, final.Reliability, final.Terms, final.SensAnalysis, final.Threshold, time.Now(), final.FID); err != nil {
 		return errors.Join(err, f.c.CloseConnection())
 	} else if n, err := result.RowsAffected(); err != nil || n == 0 {
 		return errors.Join(errors.New("nothing to update"), f.c.CloseConnection())
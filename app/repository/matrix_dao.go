@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"webApp/configs"
 	"webApp/entity"
+	"webApp/lib/bwm"
 	"webApp/lib/eval"
 	"webApp/lib/matrix"
 )
@@ -178,6 +179,34 @@ func (m *MatrixDao) UpdateMatrix(ctx context.Context, mid, ord int64, rating []e
 	return m.c.CloseConnection()
 }
 
+func (m *MatrixDao) UpdateBWM(ctx context.Context, mid int64, comparisons *bwm.Comparisons) error {
+	query := fmt.Sprintf("UPDATE %s SET bwm=$1 WHERE mid=$2", m.cfg.MatrixTable)
+
+	conn := m.c.GetConnection()
+	if conn == nil {
+		return errors.New("cant connect to db")
+	}
+
+	if _, err := conn.ExecContext(ctx, query, comparisons, mid); err != nil {
+		return errors.Join(err, m.c.CloseConnection())
+	}
+	return m.c.CloseConnection()
+}
+
+func (m *MatrixDao) UpdateLingScale(ctx context.Context, mid int64, scale *eval.LinguisticScale) error {
+	query := fmt.Sprintf("UPDATE %s SET ling_scale=$1 WHERE mid=$2", m.cfg.MatrixTable)
+
+	conn := m.c.GetConnection()
+	if conn == nil {
+		return errors.New("cant connect to db")
+	}
+
+	if _, err := conn.ExecContext(ctx, query, scale, mid); err != nil {
+		return errors.Join(err, m.c.CloseConnection())
+	}
+	return m.c.CloseConnection()
+}
+
 func (m *MatrixDao) GetExpertsRelateToTask(ctx context.Context, sid int64) ([]entity.ExpertStatus, error) {
 	query := fmt.Sprintf("SELECT uid, status FROM %s WHERE sid=$1", m.cfg.MatrixTable)
 
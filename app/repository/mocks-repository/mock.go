@@ -14,6 +14,8 @@ import (
 	sql "database/sql"
 	reflect "reflect"
 	entity "webApp/entity"
+	bwm "webApp/lib/bwm"
+	dematel "webApp/lib/dematel"
 	eval "webApp/lib/eval"
 	matrix "webApp/lib/matrix"
 	repository "webApp/repository"
@@ -90,10 +92,10 @@ func (mr *MockUserMockRecorder) GetUID(ctx, user any) *gomock.Call {
 }
 
 // GetUserByUID mocks base method.
-func (m *MockUser) GetUserByUID(ctx context.Context, uid int64) (string, error) {
+func (m *MockUser) GetUserByUID(ctx context.Context, uid int64) (*entity.UserModel, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByUID", ctx, uid)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(*entity.UserModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -207,6 +209,20 @@ func (m *MockTask) EXPECT() *MockTaskMockRecorder {
 	return m.recorder
 }
 
+// Complete mocks base method.
+func (m *MockTask) Complete(ctx context.Context, sid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, sid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTaskMockRecorder) Complete(ctx, sid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTask)(nil).Complete), ctx, sid)
+}
+
 // ConnectToTask mocks base method.
 func (m *MockTask) ConnectToTask(ctx context.Context, sid int64, password string) error {
 	m.ctrl.T.Helper()
@@ -408,6 +424,20 @@ func (mr *MockTaskMockRecorder) UpdateCriteria(ctx, sid, criteria any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCriteria", reflect.TypeOf((*MockTask)(nil).UpdateCriteria), ctx, sid, criteria)
 }
 
+// UpdateDematel mocks base method.
+func (m *MockTask) UpdateDematel(ctx context.Context, sid int64, influence *dematel.Influence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDematel", ctx, sid, influence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDematel indicates an expected call of UpdateDematel.
+func (mr *MockTaskMockRecorder) UpdateDematel(ctx, sid, influence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDematel", reflect.TypeOf((*MockTask)(nil).UpdateDematel), ctx, sid, influence)
+}
+
 // UpdateTask mocks base method.
 func (m *MockTask) UpdateTask(ctx context.Context, sid int64, input *entity.TaskModel) error {
 	m.ctrl.T.Helper()
@@ -618,6 +648,34 @@ func (mr *MockMatrixMockRecorder) SetStatusComplete(ctx, mid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatusComplete", reflect.TypeOf((*MockMatrix)(nil).SetStatusComplete), ctx, mid)
 }
 
+// UpdateBWM mocks base method.
+func (m *MockMatrix) UpdateBWM(ctx context.Context, mid int64, comparisons *bwm.Comparisons) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBWM", ctx, mid, comparisons)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBWM indicates an expected call of UpdateBWM.
+func (mr *MockMatrixMockRecorder) UpdateBWM(ctx, mid, comparisons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBWM", reflect.TypeOf((*MockMatrix)(nil).UpdateBWM), ctx, mid, comparisons)
+}
+
+// UpdateLingScale mocks base method.
+func (m *MockMatrix) UpdateLingScale(ctx context.Context, mid int64, scale *eval.LinguisticScale) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLingScale", ctx, mid, scale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLingScale indicates an expected call of UpdateLingScale.
+func (mr *MockMatrixMockRecorder) UpdateLingScale(ctx, mid, scale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLingScale", reflect.TypeOf((*MockMatrix)(nil).UpdateLingScale), ctx, mid, scale)
+}
+
 // UpdateMatrix mocks base method.
 func (m *MockMatrix) UpdateMatrix(ctx context.Context, mid, ord int64, rating []eval.Rating) error {
 	m.ctrl.T.Helper()
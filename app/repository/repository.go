@@ -5,6 +5,8 @@ import (
 	"github.com/jmoiron/sqlx"
 	"webApp/configs"
 	"webApp/entity"
+	"webApp/lib/bwm"
+	"webApp/lib/dematel"
 	"webApp/lib/eval"
 	"webApp/lib/matrix"
 )
@@ -36,6 +38,7 @@ type Task interface {
 	SetAlts(ctx context.Context, sid int64, alts entity.Alts) error
 	UpdateCriteria(ctx context.Context, sid int64, criteria entity.Criteria) error
 	UpdateAlts(ctx context.Context, sid int64, alts entity.Alts) error
+	UpdateDematel(ctx context.Context, sid int64, influence *dematel.Influence) error
 	GetCriteria(ctx context.Context, sid int64) (entity.Criteria, error)
 	GetAlts(ctx context.Context, sid int64) (entity.Alts, error)
 	GetAllSolutions(ctx context.Context, uid int64) ([]entity.TaskModel, error)
@@ -53,6 +56,8 @@ type Matrix interface {
 	DeleteMatrix(ctx context.Context, uid, sid int64) error
 	GetMID(ctx context.Context, uid, sid int64) (int64, error)
 	UpdateMatrix(ctx context.Context, mid, ord int64, rating []eval.Rating) error
+	UpdateBWM(ctx context.Context, mid int64, comparisons *bwm.Comparisons) error
+	UpdateLingScale(ctx context.Context, mid int64, scale *eval.LinguisticScale) error
 	GetMatrix(ctx context.Context, mid int64) (*matrix.Matrix, error)
 	GetExpertsRelateToTask(ctx context.Context, sid int64) ([]entity.ExpertStatus, error)
 	GetMatricesRelateToTask(ctx context.Context, sid int64) ([]entity.MatrixModel, error)
@@ -7,6 +7,7 @@ import (
 	"time"
 	"webApp/configs"
 	"webApp/entity"
+	"webApp/lib/dematel"
 )
 
 type TaskDao struct {
@@ -23,8 +24,8 @@ func NewTaskDao(factory IConnectionFactory, config *configs.DbConfig) *TaskDao {
 
 func (t *TaskDao) CreateNewTask(ctx context.Context, task *entity.TaskModel) (int64, error) {
 	query := fmt.Sprintf(`INSERT INTO %s (maintainer, password, title, description, last_change, 
-		task_type, method, calc_settings, ling_scale, status) values
-		($1, '', $2, $3, $4, $5, $6, $7, $8, $9) RETURNING sid`, t.cfg.TaskTable)
+		task_type, method, calc_settings, theta, optimistic_hesitance, ling_scale, status) values
+		($1, '', $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING sid`, t.cfg.TaskTable)
 
 	conn := t.c.GetConnection()
 	if conn == nil {
@@ -33,7 +34,7 @@ func (t *TaskDao) CreateNewTask(ctx context.Context, task *entity.TaskModel) (in
 
 	var sid int64
 	row := conn.QueryRowxContext(ctx, query, task.MaintainerID, task.Title, task.Description, time.Now(),
-		task.TaskType, task.Method, task.CalcSettings, task.LingScale, task.Status)
+		task.TaskType, task.Method, task.CalcSettings, task.Theta, task.Optimistic, task.LingScale, task.Status)
 	if err := row.Scan(&sid); err != nil {
 		return 0, errors.Join(err, t.c.CloseConnection())
 	}
@@ -61,7 +62,8 @@ func (t *TaskDao) ValidateUser(ctx context.Context, uid, sid int64) error {
 
 func (t *TaskDao) UpdateTask(ctx context.Context, sid int64, input *entity.TaskModel) error {
 	query := fmt.Sprintf(`UPDATE %s SET title=$1, description=$2, last_change=$3, task_type=$4,
-		method=$5, calc_settings=$6, ling_scale=$7, status=$8 WHERE sid=$9`, t.cfg.TaskTable)
+		method=$5, calc_settings=$6, theta=$7, optimistic_hesitance=$8, ling_scale=$9, status=$10
+		WHERE sid=$11`, t.cfg.TaskTable)
 
 	conn := t.c.GetConnection()
 	if conn == nil {
@@ -69,7 +71,7 @@ func (t *TaskDao) UpdateTask(ctx context.Context, sid int64, input *entity.TaskM
 	}
 
 	result, err := conn.ExecContext(ctx, query, input.Title, input.Description, time.Now(), input.TaskType,
-		input.Method, input.CalcSettings, input.LingScale, entity.Draft, sid)
+		input.Method, input.CalcSettings, input.Theta, input.Optimistic, input.LingScale, entity.Draft, sid)
 	if err != nil {
 		return errors.Join(err, t.c.CloseConnection())
 	} else if n, err := result.RowsAffected(); err != nil || n == 0 {
@@ -221,6 +223,22 @@ func (t *TaskDao) UpdateAlts(ctx context.Context, sid int64, alts entity.Alts) e
 	return t.c.CloseConnection()
 }
 
+func (t *TaskDao) UpdateDematel(ctx context.Context, sid int64, influence *dematel.Influence) error {
+	query := fmt.Sprintf("UPDATE %s SET dematel=$1, last_change=$2 WHERE sid=$3", t.cfg.TaskTable)
+
+	conn := t.c.GetConnection()
+	if conn == nil {
+		return errors.New("cant connect to db")
+	}
+
+	if result, err := conn.ExecContext(ctx, query, influence, time.Now(), sid); err != nil {
+		return errors.Join(err, t.c.CloseConnection())
+	} else if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return errors.Join(errors.New("nothing to update"), t.c.CloseConnection())
+	}
+	return t.c.CloseConnection()
+}
+
 func (t *TaskDao) GetAllSolutions(ctx context.Context, uid int64) ([]entity.TaskModel, error) {
 	query := fmt.Sprintf("SELECT DISTINCT ON (t.sid) t.* FROM %s t LEFT JOIN %s m ON t.sid=m.sid WHERE m.uid=$1 OR maintainer=$1",
 		t.cfg.TaskTable, t.cfg.MatrixTable)
@@ -43,6 +43,17 @@ func (m *MatrixService) GetMID(ctx context.Context, uid, sid int64) (int64, erro
 }
 
 func (m *MatrixService) UpdateMatrix(ctx context.Context, sid, mid, ord int64, rating []eval.Rating) error {
+	task, err := m.taskRepo.GetTask(ctx, sid)
+	if err != nil {
+		return err
+	}
+
+	for i := range rating {
+		if rating[i], err = task.LingScale.Resolve(rating[i]); err != nil {
+			return err
+		}
+	}
+
 	if err := m.factory.StartTransaction(); err != nil {
 		return err
 	}
@@ -71,6 +82,35 @@ func (m *MatrixService) GetRatings(ctx context.Context, uid, sid, ord int64) ([]
 	return matrix.GetAlternativeRatings(int(ord))
 }
 
+func (m *MatrixService) SetLingScale(ctx context.Context, uid, sid int64, scale *eval.LinguisticScale) error {
+	task, err := m.taskRepo.GetTask(ctx, sid)
+	if err != nil {
+		return err
+	}
+
+	if err := eval.CheckScales(&task.LingScale, scale); err != nil {
+		return err
+	}
+
+	mid, err := m.GetMID(ctx, uid, sid)
+	if err != nil {
+		return err
+	}
+
+	if err := m.factory.StartTransaction(); err != nil {
+		return err
+	}
+
+	if err := m.repo.UpdateLingScale(ctx, mid, scale); err != nil {
+		return errors.Join(err, m.factory.Rollback())
+	}
+
+	if err := m.taskRepo.SetLastChange(ctx, sid); err != nil {
+		return errors.Join(err, m.factory.Rollback())
+	}
+	return m.factory.Commit()
+}
+
 func (m *MatrixService) GetExpertsRelateToTask(ctx context.Context, sid int64) ([]entity.ExpertStatus, error) {
 	return m.repo.GetExpertsRelateToTask(ctx, sid)
 }
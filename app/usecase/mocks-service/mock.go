@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 	configs "webApp/configs"
 	entity "webApp/entity"
+	bwm "webApp/lib/bwm"
+	dematel "webApp/lib/dematel"
 	eval "webApp/lib/eval"
 	usecase "webApp/usecase"
 
@@ -87,6 +89,21 @@ func (mr *MockUserMockRecorder) GetUID(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUID", reflect.TypeOf((*MockUser)(nil).GetUID), ctx, user)
 }
 
+// GetUserInfo mocks base method.
+func (m *MockUser) GetUserInfo(ctx context.Context, uid int64) (*entity.UserModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserInfo", ctx, uid)
+	ret0, _ := ret[0].(*entity.UserModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserInfo indicates an expected call of GetUserInfo.
+func (mr *MockUserMockRecorder) GetUserInfo(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockUser)(nil).GetUserInfo), ctx, uid)
+}
+
 // GetUsersRelateToTask mocks base method.
 func (m *MockUser) GetUsersRelateToTask(ctx context.Context, sid int64) ([]entity.Expert, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +209,51 @@ func (m *MockTask) EXPECT() *MockTaskMockRecorder {
 	return m.recorder
 }
 
+// ApplyBWM mocks base method.
+func (m *MockTask) ApplyBWM(ctx context.Context, sid int64) (entity.Criteria, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyBWM", ctx, sid)
+	ret0, _ := ret[0].(entity.Criteria)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyBWM indicates an expected call of ApplyBWM.
+func (mr *MockTaskMockRecorder) ApplyBWM(ctx, sid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBWM", reflect.TypeOf((*MockTask)(nil).ApplyBWM), ctx, sid)
+}
+
+// ApplyDematel mocks base method.
+func (m *MockTask) ApplyDematel(ctx context.Context, sid int64) (entity.Criteria, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDematel", ctx, sid)
+	ret0, _ := ret[0].(entity.Criteria)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyDematel indicates an expected call of ApplyDematel.
+func (mr *MockTaskMockRecorder) ApplyDematel(ctx, sid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDematel", reflect.TypeOf((*MockTask)(nil).ApplyDematel), ctx, sid)
+}
+
+// CalcPairwise mocks base method.
+func (m *MockTask) CalcPairwise(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalcPairwise", ctx, sid, model)
+	ret0, _ := ret[0].(*entity.PairwiseResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalcPairwise indicates an expected call of CalcPairwise.
+func (mr *MockTaskMockRecorder) CalcPairwise(ctx, sid, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcPairwise", reflect.TypeOf((*MockTask)(nil).CalcPairwise), ctx, sid, model)
+}
+
 // CheckAccess mocks base method.
 func (m *MockTask) CheckAccess(ctx context.Context, uid, sid int64) error {
 	m.ctrl.T.Helper()
@@ -206,6 +268,20 @@ func (mr *MockTaskMockRecorder) CheckAccess(ctx, uid, sid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockTask)(nil).CheckAccess), ctx, uid, sid)
 }
 
+// Complete mocks base method.
+func (m *MockTask) Complete(ctx context.Context, sid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, sid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTaskMockRecorder) Complete(ctx, sid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTask)(nil).Complete), ctx, sid)
+}
+
 // ConnectToTask mocks base method.
 func (m *MockTask) ConnectToTask(ctx context.Context, sid int64, password string) error {
 	m.ctrl.T.Helper()
@@ -294,6 +370,21 @@ func (mr *MockTaskMockRecorder) GetCriteria(ctx, sid any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriteria", reflect.TypeOf((*MockTask)(nil).GetCriteria), ctx, sid)
 }
 
+// GetDematel mocks base method.
+func (m *MockTask) GetDematel(ctx context.Context, sid int64) (*dematel.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDematel", ctx, sid)
+	ret0, _ := ret[0].(*dematel.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDematel indicates an expected call of GetDematel.
+func (mr *MockTaskMockRecorder) GetDematel(ctx, sid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDematel", reflect.TypeOf((*MockTask)(nil).GetDematel), ctx, sid)
+}
+
 // GetTask mocks base method.
 func (m *MockTask) GetTask(ctx context.Context, sid int64) (*entity.TaskModel, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +414,21 @@ func (mr *MockTaskMockRecorder) SetAlts(ctx, sid, alts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlts", reflect.TypeOf((*MockTask)(nil).SetAlts), ctx, sid, alts)
 }
 
+// SetBWM mocks base method.
+func (m *MockTask) SetBWM(ctx context.Context, uid, sid int64, comparisons *bwm.Comparisons) (*bwm.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBWM", ctx, uid, sid, comparisons)
+	ret0, _ := ret[0].(*bwm.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBWM indicates an expected call of SetBWM.
+func (mr *MockTaskMockRecorder) SetBWM(ctx, uid, sid, comparisons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBWM", reflect.TypeOf((*MockTask)(nil).SetBWM), ctx, uid, sid, comparisons)
+}
+
 // SetCriteria mocks base method.
 func (m *MockTask) SetCriteria(ctx context.Context, sid int64, criteria entity.Criteria) error {
 	m.ctrl.T.Helper()
@@ -337,6 +443,21 @@ func (mr *MockTaskMockRecorder) SetCriteria(ctx, sid, criteria any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCriteria", reflect.TypeOf((*MockTask)(nil).SetCriteria), ctx, sid, criteria)
 }
 
+// SetDematel mocks base method.
+func (m *MockTask) SetDematel(ctx context.Context, sid int64, influence *dematel.Influence) (*dematel.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDematel", ctx, sid, influence)
+	ret0, _ := ret[0].(*dematel.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDematel indicates an expected call of SetDematel.
+func (mr *MockTaskMockRecorder) SetDematel(ctx, sid, influence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDematel", reflect.TypeOf((*MockTask)(nil).SetDematel), ctx, sid, influence)
+}
+
 // SetExpertsWeights mocks base method.
 func (m *MockTask) SetExpertsWeights(ctx context.Context, sid int64, weights entity.Weights) error {
 	m.ctrl.T.Helper()
@@ -351,6 +472,21 @@ func (mr *MockTaskMockRecorder) SetExpertsWeights(ctx, sid, weights any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExpertsWeights", reflect.TypeOf((*MockTask)(nil).SetExpertsWeights), ctx, sid, weights)
 }
 
+// SetPairwiseWeights mocks base method.
+func (m *MockTask) SetPairwiseWeights(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPairwiseWeights", ctx, sid, model)
+	ret0, _ := ret[0].(*entity.PairwiseResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPairwiseWeights indicates an expected call of SetPairwiseWeights.
+func (mr *MockTaskMockRecorder) SetPairwiseWeights(ctx, sid, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPairwiseWeights", reflect.TypeOf((*MockTask)(nil).SetPairwiseWeights), ctx, sid, model)
+}
+
 // SetPassword mocks base method.
 func (m *MockTask) SetPassword(ctx context.Context, sid int64, password string) error {
 	m.ctrl.T.Helper()
@@ -532,6 +668,20 @@ func (mr *MockMatrixMockRecorder) IsAllStatusesComplete(ctx, sid any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllStatusesComplete", reflect.TypeOf((*MockMatrix)(nil).IsAllStatusesComplete), ctx, sid)
 }
 
+// SetLingScale mocks base method.
+func (m *MockMatrix) SetLingScale(ctx context.Context, uid, sid int64, scale *eval.LinguisticScale) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLingScale", ctx, uid, sid, scale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLingScale indicates an expected call of SetLingScale.
+func (mr *MockMatrixMockRecorder) SetLingScale(ctx, uid, sid, scale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLingScale", reflect.TypeOf((*MockMatrix)(nil).SetLingScale), ctx, uid, sid, scale)
+}
+
 // SetStatusComplete mocks base method.
 func (m *MockMatrix) SetStatusComplete(ctx context.Context, mid int64) error {
 	m.ctrl.T.Helper()
@@ -4,6 +4,8 @@ import (
 	"context"
 	"webApp/configs"
 	"webApp/entity"
+	"webApp/lib/bwm"
+	"webApp/lib/dematel"
 	"webApp/lib/eval"
 	"webApp/repository"
 )
@@ -37,6 +39,13 @@ type Task interface {
 	UpdateCriteria(ctx context.Context, sid int64, criteria entity.Criteria) error
 	UpdateAlts(ctx context.Context, sid int64, alts entity.Alts) error
 	GetCriteria(ctx context.Context, sid int64) (entity.Criteria, error)
+	CalcPairwise(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error)
+	SetPairwiseWeights(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error)
+	SetBWM(ctx context.Context, uid, sid int64, comparisons *bwm.Comparisons) (*bwm.Result, error)
+	ApplyBWM(ctx context.Context, sid int64) (entity.Criteria, error)
+	SetDematel(ctx context.Context, sid int64, influence *dematel.Influence) (*dematel.Result, error)
+	GetDematel(ctx context.Context, sid int64) (*dematel.Result, error)
+	ApplyDematel(ctx context.Context, sid int64) (entity.Criteria, error)
 	GetAlts(ctx context.Context, sid int64) (entity.Alts, error)
 	GetAllSolutions(ctx context.Context, uid int64) ([]entity.TaskShortCard, error)
 	ConnectToTask(ctx context.Context, sid int64, password string) error
@@ -49,6 +58,7 @@ type Matrix interface {
 	GetMID(ctx context.Context, uid, sid int64) (int64, error)
 	UpdateMatrix(ctx context.Context, sid, mid, ord int64, rating []eval.Rating) error
 	GetRatings(ctx context.Context, uid, sid, ord int64) ([]eval.Rating, error)
+	SetLingScale(ctx context.Context, uid, sid int64, scale *eval.LinguisticScale) error
 	GetExpertsRelateToTask(ctx context.Context, sid int64) ([]entity.ExpertStatus, error)
 	SetStatusComplete(ctx context.Context, mid int64) error
 	DeactivateStatuses(ctx context.Context, sid int64) error
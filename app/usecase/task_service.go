@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"webApp/entity"
+	"webApp/lib/bwm"
+	"webApp/lib/dematel"
 	"webApp/lib/eval"
+	v "webApp/lib/variables"
 	"webApp/repository"
 )
 
@@ -51,6 +54,8 @@ func (t *TaskService) GetTask(ctx context.Context, sid int64) (*entity.TaskModel
 		TaskType:     task.TaskType,
 		Method:       task.Method,
 		CalcSettings: task.CalcSettings,
+		Theta:        task.Theta,
+		Optimistic:   task.Optimistic,
 		LingScale:    task.LingScale,
 	}, nil
 }
@@ -156,6 +161,150 @@ func (t *TaskService) GetCriteria(ctx context.Context, sid int64) (entity.Criter
 	return t.repo.GetCriteria(ctx, sid)
 }
 
+func (t *TaskService) CalcPairwise(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error) {
+	criteria, err := t.GetCriteria(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteria) != model.Size() {
+		return nil, errors.New("size of comparisons doesn't match count of criteria")
+	}
+	return entity.CalcPairwise(model)
+}
+
+func (t *TaskService) SetPairwiseWeights(ctx context.Context, sid int64, model *entity.PairwiseModel) (*entity.PairwiseResult, error) {
+	criteria, err := t.GetCriteria(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteria) != model.Size() {
+		return nil, errors.New("size of comparisons doesn't match count of criteria")
+	}
+
+	result, err := entity.CalcPairwise(model)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.IsAcceptable() && !model.Force {
+		return result, v.InconsistentMatrix
+	}
+
+	for i := range criteria {
+		criteria[i].Weight = result.Weights[i]
+	}
+	return result, t.repo.UpdateCriteria(ctx, sid, criteria)
+}
+
+func (t *TaskService) SetBWM(ctx context.Context, uid, sid int64, comparisons *bwm.Comparisons) (*bwm.Result, error) {
+	criteria, err := t.GetCriteria(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteria) != comparisons.Size() {
+		return nil, errors.New("size of comparisons doesn't match count of criteria")
+	}
+
+	result, err := comparisons.Weights()
+	if err != nil {
+		return nil, err
+	}
+
+	mid, err := t.matrixRepo.GetMID(ctx, uid, sid)
+	if err != nil {
+		return nil, err
+	}
+	return &result, t.matrixRepo.UpdateBWM(ctx, mid, comparisons)
+}
+
+func (t *TaskService) ApplyBWM(ctx context.Context, sid int64) (entity.Criteria, error) {
+	task, err := t.repo.GetTask(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	matrices, err := t.matrixRepo.GetMatricesRelateToTask(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	weights, err := entity.AggregateBWM(matrices, task.ExpertsWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights) != len(task.Criteria) {
+		return nil, errors.New("size of comparisons doesn't match count of criteria")
+	}
+
+	for i := range task.Criteria {
+		task.Criteria[i].Weight = weights[i]
+	}
+	return task.Criteria, t.repo.UpdateCriteria(ctx, sid, task.Criteria)
+}
+
+func (t *TaskService) SetDematel(ctx context.Context, sid int64, influence *dematel.Influence) (*dematel.Result, error) {
+	criteria, err := t.GetCriteria(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(criteria) != influence.Size() {
+		return nil, errors.New("size of influence matrix doesn't match count of criteria")
+	}
+
+	result, err := influence.Calc()
+	if err != nil {
+		return nil, err
+	}
+	return &result, t.repo.UpdateDematel(ctx, sid, influence)
+}
+
+func (t *TaskService) GetDematel(ctx context.Context, sid int64) (*dematel.Result, error) {
+	task, err := t.repo.GetTask(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Dematel == nil {
+		return nil, errors.New("influence matrix isn't specified")
+	}
+
+	result, err := task.Dematel.Calc()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (t *TaskService) ApplyDematel(ctx context.Context, sid int64) (entity.Criteria, error) {
+	task, err := t.repo.GetTask(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Dematel == nil {
+		return nil, errors.New("influence matrix isn't specified")
+	}
+
+	result, err := task.Dematel.Calc()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Weights) != len(task.Criteria) {
+		return nil, errors.New("size of influence matrix doesn't match count of criteria")
+	}
+
+	for i := range task.Criteria {
+		task.Criteria[i].Weight = eval.Rating{Evaluated: result.Weights[i]}
+	}
+	return task.Criteria, t.repo.UpdateCriteria(ctx, sid, task.Criteria)
+}
+
 func (t *TaskService) GetAlts(ctx context.Context, sid int64) (entity.Alts, error) {
 	return t.repo.GetAlts(ctx, sid)
 }
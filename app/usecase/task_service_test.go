@@ -8,6 +8,7 @@ import (
 	"testing"
 	"webApp/entity"
 	"webApp/lib/eval"
+	"webApp/lib/matrix"
 	v "webApp/lib/variables"
 	"webApp/repository"
 	mock_repository "webApp/repository/mocks-repository"
@@ -233,8 +234,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -258,8 +259,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -283,8 +284,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 		{
 			name: "Fail find alts",
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -301,8 +302,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -324,8 +325,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -349,8 +350,8 @@ func TestTaskService_UpdateAlts(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Alts, output entity.Alts, criteria entity.Criteria) {
@@ -408,12 +409,12 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 		{
 			name: "Ok",
 			inputCriteria: entity.Criteria{
-				{"test", "smt", eval.Rating{eval.Number(0.4)}, v.Cost},
-				{"test2", "smt", eval.Rating{eval.Number(0.6)}, v.Cost},
+				{"test", "smt", eval.Rating{eval.Number(0.4)}, v.Cost, matrix.Thresholds{}},
+				{"test2", "smt", eval.Rating{eval.Number(0.6)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputAlts: entity.Alts{
 				{"title1", "test"},
@@ -433,13 +434,13 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 		{
 			name: "Ok with nullify matrix",
 			inputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
-				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
+				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputAlts: entity.Alts{
 				{"title1", "test"},
@@ -468,8 +469,8 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 		{
 			name: "Fail find alts",
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Criteria, output entity.Criteria, alts entity.Alts) {
@@ -486,8 +487,8 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 				{"title3", "test"},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			mockBehavior: func(t *mock_repository.MockTask, m *mock_repository.MockMatrix, f *mock_repository.MockIConnectionFactory,
 				input entity.Criteria, output entity.Criteria, alts entity.Alts) {
@@ -500,13 +501,13 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 		{
 			name: "Fail update alts",
 			inputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
-				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
+				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputAlts: entity.Alts{
 				{"title1", "test"},
@@ -526,13 +527,13 @@ func TestTaskService_UpdateCriteria(t *testing.T) {
 		{
 			name: "Fail nullify matrix",
 			inputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
-				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
+				{"c3", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputCriteria: entity.Criteria{
-				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit},
-				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost},
+				{"c1", "smt", eval.Rating{eval.Number(0.5)}, v.Benefit, matrix.Thresholds{}},
+				{"c2", "smt", eval.Rating{eval.Number(0.5)}, v.Cost, matrix.Thresholds{}},
 			},
 			outputAlts: entity.Alts{
 				{"title1", "test"},
@@ -0,0 +1,7 @@
+ALTER TABLE final DROP COLUMN acceptance;
+
+DELETE FROM tasks WHERE method IN ('vikor');
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart'));
@@ -0,0 +1,7 @@
+ALTER TABLE final DROP COLUMN partial_order;
+
+DELETE FROM tasks WHERE method IN ('promethee');
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart', 'vikor'));
@@ -0,0 +1,5 @@
+ALTER TABLE final ADD COLUMN partial_order json;
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart', 'vikor', 'promethee'));
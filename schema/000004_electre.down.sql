@@ -0,0 +1,5 @@
+DELETE FROM tasks WHERE method IN ('electre');
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart', 'vikor', 'promethee'));
@@ -0,0 +1 @@
+ALTER TABLE matrices ADD COLUMN bwm json;
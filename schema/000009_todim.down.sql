@@ -0,0 +1,7 @@
+ALTER TABLE tasks DROP COLUMN theta;
+
+DELETE FROM tasks WHERE method IN ('todim');
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart', 'vikor', 'promethee', 'electre', 'multimoora', 'edas', 'codas', 'mabac', 'aras'));
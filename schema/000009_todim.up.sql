@@ -0,0 +1,5 @@
+ALTER TABLE tasks ADD COLUMN theta double precision not null default 1;
+
+ALTER TABLE tasks DROP CONSTRAINT tasks_method_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_method_check
+    CHECK (method IN ('topsis', 'smart', 'vikor', 'promethee', 'electre', 'multimoora', 'edas', 'codas', 'mabac', 'aras', 'todim'));
@@ -0,0 +1 @@
+ALTER TABLE final DROP COLUMN reliability;
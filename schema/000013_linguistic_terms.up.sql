@@ -0,0 +1 @@
+ALTER TABLE final ADD COLUMN terms json;
@@ -0,0 +1 @@
+ALTER TABLE matrices DROP COLUMN ling_scale;
@@ -0,0 +1 @@
+ALTER TABLE matrices ADD COLUMN ling_scale json;
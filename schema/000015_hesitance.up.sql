@@ -0,0 +1 @@
+ALTER TABLE tasks ADD COLUMN optimistic_hesitance boolean not null default false;